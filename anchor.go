@@ -0,0 +1,87 @@
+package treesitter
+
+import "errors"
+
+var (
+	ErrAnchorDeleted  = errors.New("anchored node was deleted")
+	ErrAnchorNotFound = errors.New("anchored node not found")
+)
+
+// Anchor remembers the position and type of a node so that the node can be
+// found again in a tree reparsed after edits, without holding on to the old tree.
+type Anchor struct {
+	StartByte int
+	EndByte   int
+	Symbol    Symbol
+
+	deleted bool
+}
+
+// NewAnchor returns an anchor for n.
+func NewAnchor(n Node) Anchor {
+	return Anchor{StartByte: n.StartByte(), EndByte: n.EndByte(), Symbol: n.Symbol()}
+}
+
+// Deleted reports whether an edit removed the whole anchored node.
+func (a Anchor) Deleted() bool { return a.deleted }
+
+// Edit returns the anchor moved through edits, applied in order as with Tree.Edit:
+// each edit is expressed in the coordinates left by the previous ones.
+//
+// An edit before the node shifts it and one inside it grows or shrinks it, as does one
+// replacing exactly the node's bytes, such as a rename. An edit removing the node's bytes,
+// or replacing more than them, deletes it. An edit overlapping one end of the node
+// cuts it down to the part outside of the edit.
+func (a Anchor) Edit(edits ...EditInput) Anchor {
+	for _, e := range edits {
+		if a.deleted {
+			break
+		}
+		delta := e.NewEndIndex - e.OldEndIndex
+		switch {
+		case e.OldEndIndex <= a.StartByte && (e.StartIndex < a.StartByte || e.StartIndex == e.OldEndIndex):
+			// before the node, including insertions right at its start
+			a.StartByte += delta
+			a.EndByte += delta
+		case e.StartIndex >= a.EndByte:
+			// after the node
+		case e.StartIndex == a.StartByte && e.OldEndIndex == a.EndByte && e.NewEndIndex > e.StartIndex:
+			// replaces the node
+			a.EndByte += delta
+		case e.StartIndex <= a.StartByte && e.OldEndIndex >= a.EndByte:
+			a.deleted = true
+		case e.StartIndex < a.StartByte:
+			// overlaps the start of the node
+			a.StartByte = e.NewEndIndex
+			a.EndByte += delta
+		case e.OldEndIndex > a.EndByte:
+			// overlaps the end of the node
+			a.EndByte = e.StartIndex
+		default:
+			// inside the node
+			a.EndByte += delta
+		}
+	}
+	return a
+}
+
+// Resolve moves the anchor through edits, as with Edit, and returns the node of tree
+// with the anchored node's type that spans exactly the resulting range.
+//
+// It returns ErrAnchorDeleted if the node was deleted by an edit, and
+// ErrAnchorNotFound if tree has no matching node, e.g. because the edits changed its type.
+func (a Anchor) Resolve(tree *Tree, edits ...EditInput) (Node, error) {
+	a = a.Edit(edits...)
+	if a.deleted {
+		return Node{}, ErrAnchorDeleted
+	}
+	for n := tree.RootNode().DescendantForByteRange(a.StartByte, a.EndByte); !n.IsNull(); n = n.Parent() {
+		if n.StartByte() != a.StartByte || n.EndByte() != a.EndByte {
+			break
+		}
+		if n.Symbol() == a.Symbol {
+			return n, nil
+		}
+	}
+	return Node{}, ErrAnchorNotFound
+}
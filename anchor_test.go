@@ -0,0 +1,114 @@
+package treesitter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnchor(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	reparse := func(tree *Tree, src string, edits ...EditInput) *Tree {
+		tree = tree.Copy()
+		for _, e := range edits {
+			tree.Edit(e)
+		}
+		newTree, err := parser.Parse(context.Background(), tree, []byte(src))
+		assert.NoError(err)
+		return newTree
+	}
+	edit := func(start, oldEnd, newEnd int) EditInput {
+		return EditInput{
+			StartIndex:  start,
+			OldEndIndex: oldEnd,
+			NewEndIndex: newEnd,
+			StartPoint:  Point{Column: start},
+			OldEndPoint: Point{Column: oldEnd},
+			NewEndPoint: Point{Column: newEnd},
+		}
+	}
+
+	src := "1 + 22 + 3"
+	tree, err := parser.Parse(context.Background(), nil, []byte(src))
+	assert.NoError(err)
+	root := tree.RootNode()
+	three := root.DescendantForByteRange(9, 10)
+	twentyTwo := root.DescendantForByteRange(4, 6)
+	inner := root.Child(0).ChildByFieldName("left").Child(0)
+	assert.Equal("number", three.Type())
+	assert.Equal("number", twentyTwo.Type())
+	assert.Equal("sum", inner.Type())
+
+	// 1 -> 100, then 3 -> 33
+	edits := []EditInput{edit(0, 1, 3), edit(11, 12, 13)}
+	newSrc := "100 + 22 + 33"
+	newTree := reparse(tree, newSrc, edits...)
+
+	n, err := NewAnchor(twentyTwo).Resolve(newTree, edits...)
+	assert.NoError(err)
+	assert.Equal("22", n.Text([]byte(newSrc)))
+
+	n, err = NewAnchor(inner).Resolve(newTree, edits...)
+	assert.NoError(err)
+	assert.Equal("100 + 22", n.Text([]byte(newSrc)))
+
+	// replacing exactly the node's bytes renames it
+	n, err = NewAnchor(three).Resolve(newTree, edits...)
+	assert.NoError(err)
+	assert.Equal("33", n.Text([]byte(newSrc)))
+	assert.False(NewAnchor(three).Edit(edits...).Deleted())
+
+	// 22 -> 7
+	edits = []EditInput{edit(4, 6, 5)}
+	newSrc = "1 + 7 + 3"
+	newTree = reparse(tree, newSrc, edits...)
+	n, err = NewAnchor(twentyTwo).Resolve(newTree, edits...)
+	assert.NoError(err)
+	assert.Equal("7", n.Text([]byte(newSrc)))
+	n, err = NewAnchor(three).Resolve(newTree, edits...)
+	assert.NoError(err)
+	assert.Equal("3", n.Text([]byte(newSrc)))
+
+	// removing the node, or replacing more than it, deletes it
+	for _, e := range []EditInput{edit(9, 10, 9), edit(8, 10, 9), edit(7, 10, 8)} {
+		assert.True(NewAnchor(three).Edit(e).Deleted(), e)
+	}
+	edits = []EditInput{edit(6, 10, 6)}
+	newTree = reparse(tree, "1 + 22", edits...)
+	_, err = NewAnchor(three).Resolve(newTree, edits...)
+	assert.ErrorIs(err, ErrAnchorDeleted)
+
+	// edits touching the ends of the node from outside of it
+	a := NewAnchor(twentyTwo).Edit(edit(2, 4, 3))
+	assert.Equal(3, a.StartByte)
+	assert.Equal(5, a.EndByte)
+	a = NewAnchor(twentyTwo).Edit(edit(6, 8, 6))
+	assert.Equal(4, a.StartByte)
+	assert.Equal(6, a.EndByte)
+	a = NewAnchor(twentyTwo).Edit(edit(4, 4, 5))
+	assert.Equal(5, a.StartByte)
+	assert.Equal(7, a.EndByte)
+	a = NewAnchor(twentyTwo).Edit(edit(6, 6, 7))
+	assert.Equal(4, a.StartByte)
+	assert.Equal(6, a.EndByte)
+
+	// 22 -> 2+ 2 turns the number into a sum
+	edits = []EditInput{edit(5, 6, 8)}
+	newTree = reparse(tree, "1 + 2+ 2 + 3", edits...)
+	_, err = NewAnchor(twentyTwo).Resolve(newTree, edits...)
+	assert.ErrorIs(err, ErrAnchorNotFound)
+	a = NewAnchor(twentyTwo).Edit(edits...)
+	assert.Equal(4, a.StartByte)
+	assert.Equal(8, a.EndByte)
+
+	// edits overlapping one end of the node
+	a = NewAnchor(inner).Edit(edit(4, 7, 5))
+	assert.Equal(0, a.StartByte)
+	assert.Equal(4, a.EndByte)
+	a = NewAnchor(twentyTwo).Edit(edit(2, 5, 2))
+	assert.Equal(2, a.StartByte)
+	assert.Equal(3, a.EndByte)
+}
@@ -0,0 +1,120 @@
+package treesitter
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// Arena owns parsers, trees, tree cursors, queries and query cursors created through it
+// and frees them all deterministically when it is closed.
+//
+// Objects owned by an Arena have no finalizers, so their C memory is released exactly
+// when Close is called instead of whenever the garbage collector gets to it.
+// Trees produced by an arena-owned Parser, including their copies, are owned by the same Arena.
+// None of the owned objects, nor any Node obtained from them, may be used after Close.
+// Trees closed before the arena are no longer owned by it, so that an arena can outlive
+// any number of them.
+type Arena struct {
+	mu sync.Mutex
+	// owned maps the objects owned by the arena to how to free them.
+	owned map[any]owned
+	// created counts the objects owned so far, to free them in reverse order.
+	created int
+}
+
+type owned struct {
+	order int
+	close func()
+}
+
+// NewArena creates an empty Arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// own makes the arena free obj with close when it is closed.
+func (a *Arena) own(obj any, close func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.owned == nil {
+		a.owned = map[any]owned{}
+	}
+	a.created++
+	a.owned[obj] = owned{order: a.created, close: close}
+}
+
+// disown forgets obj, which has been freed before the arena was closed.
+func (a *Arena) disown(obj any) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.owned, obj)
+}
+
+// Close frees all the objects owned by the arena, most recently created first.
+// The arena may be reused after Close.
+func (a *Arena) Close() {
+	a.mu.Lock()
+	objs := make([]owned, 0, len(a.owned))
+	for _, o := range a.owned {
+		objs = append(objs, o)
+	}
+	a.owned = nil
+	a.mu.Unlock()
+
+	slices.SortFunc(objs, func(a, b owned) int { return b.order - a.order })
+	for _, o := range objs {
+		o.close()
+	}
+}
+
+// NewParser creates new Parser owned by the arena.
+// It returns an error wrapping ErrUnknownLanguage if the language has not been registered.
+func (a *Arena) NewParser(language string) (*Parser, error) {
+	p, err := NewParserChecked(language)
+	if err != nil {
+		return nil, err
+	}
+	a.adoptParser(p)
+	return p, nil
+}
+
+// NewParserFromLanguage creates new Parser for the given Language owned by the arena.
+func (a *Arena) NewParserFromLanguage(lang *Language) *Parser {
+	p := NewParserFromLanguage(lang)
+	a.adoptParser(p)
+	return p
+}
+
+func (a *Arena) adoptParser(p *Parser) {
+	runtime.SetFinalizer(p, nil)
+	p.arena = a
+	a.own(p, p.Close)
+}
+
+// NewTreeCursor creates a new tree cursor owned by the arena starting from the given node.
+func (a *Arena) NewTreeCursor(n Node) *TreeCursor {
+	c := NewTreeCursor(n)
+	runtime.SetFinalizer(c, nil)
+	a.own(c, c.Close)
+	return c
+}
+
+// NewQuery creates a query owned by the arena; see NewQuery.
+func (a *Arena) NewQuery(pattern []byte, language string) (*Query, error) {
+	q, err := NewQuery(pattern, language)
+	if err != nil {
+		return nil, err
+	}
+	runtime.SetFinalizer(q, nil)
+	a.own(q, q.Close)
+	return q, nil
+}
+
+// NewQueryCursor creates a query cursor owned by the arena.
+func (a *Arena) NewQueryCursor() *QueryCursor {
+	qc := NewQueryCursor()
+	runtime.SetFinalizer(qc, nil)
+	a.own(qc, qc.Close)
+	return qc
+}
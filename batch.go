@@ -0,0 +1,77 @@
+package treesitter
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// FileResult holds the outcome of parsing a single file with ParseFiles.
+type FileResult struct {
+	Tree *Tree
+	Err  error
+}
+
+// ParseFiles parses every file in files concurrently and returns a result per path.
+//
+// langDetect maps a path to a registered language name; files for which it returns
+// an unregistered name (or "") get an error wrapping ErrUnknownLanguage.
+// At most concurrency files are parsed at the same time; if concurrency <= 0,
+// runtime.GOMAXPROCS(0) is used. Each worker keeps one Parser per language
+// and reuses it for all the files it handles.
+func ParseFiles(ctx context.Context, files map[string][]byte, langDetect func(path string) string, concurrency int) map[string]FileResult {
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	concurrency = min(concurrency, len(files))
+
+	paths := make(chan string)
+	go func() {
+		defer close(paths)
+		for path := range files {
+			paths <- path
+		}
+	}()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]FileResult, len(files))
+	)
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			parsers := map[string]*Parser{}
+			for path := range paths {
+				res := parseFile(ctx, parsers, path, files[path], langDetect(path))
+				mu.Lock()
+				results[path] = res
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+func parseFile(ctx context.Context, parsers map[string]*Parser, path string, content []byte, lang string) FileResult {
+	if err := ctx.Err(); err != nil {
+		return FileResult{Err: err}
+	}
+	p := parsers[lang]
+	if p == nil {
+		var err error
+		if p, err = NewParserChecked(lang); err != nil {
+			return FileResult{Err: fmt.Errorf("%s: %w", path, err)}
+		}
+		parsers[lang] = p
+	}
+	tree, err := p.Parse(ctx, nil, content)
+	if err != nil {
+		return FileResult{Err: fmt.Errorf("%s: %w", path, err)}
+	}
+	return FileResult{Tree: tree}
+}
@@ -1,5 +1,7 @@
 #include "api.h"
 #include "bindings.h"
+#include "language.h"
+#include "tree_cursor.h"
 #include <string.h>
 #include <stdio.h>
 
@@ -47,4 +49,169 @@ TSTree *call_ts_parser_parse(TSParser *self, const TSTree *old_tree, int read_fu
         free(payload.previous_content);
     }
     return tree;
-}
\ No newline at end of file
+}
+uint32_t node_children(TSNode self, bool named, TSNode *out, uint32_t len)
+{
+    uint32_t n = 0;
+    if (len == 0)
+        return 0;
+    TSTreeCursor cursor = ts_tree_cursor_new(self);
+    if (ts_tree_cursor_goto_first_child(&cursor))
+    {
+        do
+        {
+            TSNode child = ts_tree_cursor_current_node(&cursor);
+            if (!named || ts_node_is_named(child))
+                out[n++] = child;
+        } while (n < len && ts_tree_cursor_goto_next_sibling(&cursor));
+    }
+    ts_tree_cursor_delete(&cursor);
+    return n;
+}
+
+// The flag bits must match NodeFlags in flat.go.
+enum
+{
+    FLAT_NAMED = 1 << 0,
+    FLAT_MISSING = 1 << 1,
+    FLAT_EXTRA = 1 << 2,
+    FLAT_ERROR = 1 << 3,
+    FLAT_HAS_ERROR = 1 << 4,
+};
+
+uint32_t tree_flatten(TSNode root, uint32_t len, TSSymbol *symbols, uint8_t *flags, TSFieldId *fields, int32_t *parents,
+                      uint32_t *start_bytes, uint32_t *end_bytes, TSPoint *start_points, TSPoint *end_points)
+{
+    uint32_t n = 0;
+    int32_t parent = -1;
+    TSTreeCursor cursor = ts_tree_cursor_new(root);
+    while (n < len)
+    {
+        TSNode node = ts_tree_cursor_current_node(&cursor);
+        uint8_t f = 0;
+        if (ts_node_is_named(node))
+            f |= FLAT_NAMED;
+        if (ts_node_is_missing(node))
+            f |= FLAT_MISSING;
+        if (ts_node_is_extra(node))
+            f |= FLAT_EXTRA;
+        if (ts_node_is_error(node))
+            f |= FLAT_ERROR;
+        if (ts_node_has_error(node))
+            f |= FLAT_HAS_ERROR;
+        symbols[n] = ts_node_symbol(node);
+        flags[n] = f;
+        fields[n] = ts_tree_cursor_current_field_id(&cursor);
+        parents[n] = parent;
+        start_bytes[n] = ts_node_start_byte(node);
+        end_bytes[n] = ts_node_end_byte(node);
+        start_points[n] = ts_node_start_point(node);
+        end_points[n] = ts_node_end_point(node);
+        n++;
+
+        if (ts_tree_cursor_goto_first_child(&cursor))
+        {
+            parent = n - 1;
+            continue;
+        }
+        while (!ts_tree_cursor_goto_next_sibling(&cursor))
+        {
+            if (!ts_tree_cursor_goto_parent(&cursor))
+            {
+                ts_tree_cursor_delete(&cursor);
+                return n;
+            }
+            parent = parents[parent];
+        }
+    }
+    ts_tree_cursor_delete(&cursor);
+    return n;
+}
+
+TSFieldId node_field_id_for_child(TSNode self, uint32_t child_index)
+{
+    // the cursor walks through hidden children and reports the fields they inherit,
+    // without going through field names
+    TSTreeCursor cursor = ts_tree_cursor_new(self);
+    TSFieldId field_id = 0;
+    if (ts_tree_cursor_goto_first_child(&cursor))
+    {
+        uint32_t index = 0;
+        while (index < child_index && ts_tree_cursor_goto_next_sibling(&cursor))
+            index++;
+        if (index == child_index)
+            field_id = ts_tree_cursor_current_field_id(&cursor);
+    }
+    ts_tree_cursor_delete(&cursor);
+    return field_id;
+}
+
+uint32_t language_valid_tokens(const TSLanguage *self, TSStateId state, TSSymbol *out, uint32_t len)
+{
+    if (state >= self->state_count)
+        return 0;
+    LookaheadIterator iterator = ts_language_lookaheads(self, state);
+    uint32_t n = 0;
+    while (ts_lookahead_iterator__next(&iterator))
+    {
+        // non-terminals only have a successor state, and extras are valid everywhere
+        if (iterator.symbol >= self->token_count)
+            continue;
+        bool valid = false;
+        for (uint32_t i = 0; i < iterator.action_count; i++)
+        {
+            TSParseAction action = iterator.actions[i];
+            if (action.type == TSParseActionTypeReduce || action.type == TSParseActionTypeAccept ||
+                (action.type == TSParseActionTypeShift && !action.shift.extra))
+            {
+                valid = true;
+                break;
+            }
+        }
+        if (!valid)
+            continue;
+        if (n < len)
+            out[n] = iterator.symbol;
+        n++;
+    }
+    return n;
+}
+
+bool language_symbol_is_supertype(const TSLanguage *self, TSSymbol symbol)
+{
+    return ts_language_symbol_metadata(self, symbol).supertype;
+}
+
+static bool cursor_goto_node(TSTreeCursor *cursor, TSNode target)
+{
+    if (ts_node_eq(ts_tree_cursor_current_node(cursor), target))
+        return true;
+    if (!ts_tree_cursor_goto_first_child(cursor))
+        return false;
+    do
+    {
+        TSNode node = ts_tree_cursor_current_node(cursor);
+        if (ts_node_start_byte(node) <= ts_node_start_byte(target) &&
+            ts_node_end_byte(node) >= ts_node_end_byte(target) && cursor_goto_node(cursor, target))
+            return true;
+    } while (ts_tree_cursor_goto_next_sibling(cursor));
+    ts_tree_cursor_goto_parent(cursor);
+    return false;
+}
+
+uint32_t node_supertypes(TSNode self, TSSymbol *out, uint32_t len)
+{
+    // only a cursor walking down from the root keeps track of the hidden nodes above self
+    TSTreeCursor cursor = ts_tree_cursor_new(ts_tree_root_node(self.tree));
+    unsigned n = 0;
+    if (cursor_goto_node(&cursor, self))
+    {
+        TSFieldId field_id;
+        bool has_later_siblings, has_later_named_siblings, can_have_later_siblings_with_this_field;
+        n = len;
+        ts_tree_cursor_current_status(&cursor, &field_id, &has_later_siblings, &has_later_named_siblings,
+                                      &can_have_later_siblings_with_this_field, out, &n);
+    }
+    ts_tree_cursor_delete(&cursor);
+    return n;
+}
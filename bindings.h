@@ -13,5 +13,12 @@ typedef struct
 
 extern char *callReadFunc(int id, uint32_t byteIndex, TSPoint position, uint32_t *bytesRead);
 TSTree *call_ts_parser_parse(TSParser *self, const TSTree *old_tree, int read_function_id, TSInputEncoding encoding);
+uint32_t node_children(TSNode self, bool named, TSNode *out, uint32_t len);
+uint32_t tree_flatten(TSNode root, uint32_t len, TSSymbol *symbols, uint8_t *flags, TSFieldId *fields, int32_t *parents,
+                      uint32_t *start_bytes, uint32_t *end_bytes, TSPoint *start_points, TSPoint *end_points);
+TSFieldId node_field_id_for_child(TSNode self, uint32_t child_index);
+uint32_t language_valid_tokens(const TSLanguage *self, TSStateId state, TSSymbol *out, uint32_t len);
+bool language_symbol_is_supertype(const TSLanguage *self, TSSymbol symbol);
+uint32_t node_supertypes(TSNode self, TSSymbol *out, uint32_t len);
 
 #endif
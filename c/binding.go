@@ -1,15 +1,29 @@
 package c
 
+//go:generate go run ../cmd/tsgen -o nodes.go node-types.json
+//go:generate go run ../cmd/tsgen -consts -language c -o symbols.go
+
 //#include "parser.h"
 //TSLanguage *tree_sitter_c();
 import "C"
 import (
+	_ "embed"
 	"unsafe"
 
 	"github.com/boldsoftware/treesitter"
 )
 
+var language = treesitter.NewLanguage(unsafe.Pointer(C.tree_sitter_c()))
+
+//go:embed node-types.json
+var nodeTypes []byte
+
 func init() {
-	ptr := unsafe.Pointer(C.tree_sitter_c())
-	treesitter.RegisterLanguage("c", treesitter.NewLanguage(ptr))
+	if err := language.SetNodeTypes(nodeTypes); err != nil {
+		panic(err)
+	}
+	treesitter.RegisterLanguage("c", language)
 }
+
+// GetLanguage returns the C language, which is also registered as "c".
+func GetLanguage() *treesitter.Language { return language }
@@ -0,0 +1,2372 @@
+// Code generated by tsgen. DO NOT EDIT.
+
+package c
+
+import "github.com/boldsoftware/treesitter"
+
+// AbstractDeclarator wraps nodes of any of the subtypes of _abstract_declarator.
+type AbstractDeclarator struct{ treesitter.Node }
+
+// AsAbstractDeclarator converts n to AbstractDeclarator, reporting whether it is of a subtype of _abstract_declarator.
+func AsAbstractDeclarator(n treesitter.Node) (AbstractDeclarator, bool) {
+	switch n.Type() {
+	case "abstract_array_declarator", "abstract_function_declarator", "abstract_parenthesized_declarator", "abstract_pointer_declarator":
+		return AbstractDeclarator{n}, n.IsNamed()
+	}
+	return AbstractDeclarator{n}, false
+}
+
+// Declarator wraps nodes of any of the subtypes of _declarator.
+type Declarator struct{ treesitter.Node }
+
+// AsDeclarator converts n to Declarator, reporting whether it is of a subtype of _declarator.
+func AsDeclarator(n treesitter.Node) (Declarator, bool) {
+	switch n.Type() {
+	case "array_declarator", "attributed_declarator", "function_declarator", "identifier", "parenthesized_declarator", "pointer_declarator":
+		return Declarator{n}, n.IsNamed()
+	}
+	return Declarator{n}, false
+}
+
+// AnyFieldDeclarator wraps nodes of any of the subtypes of _field_declarator.
+type AnyFieldDeclarator struct{ treesitter.Node }
+
+// AsAnyFieldDeclarator converts n to AnyFieldDeclarator, reporting whether it is of a subtype of _field_declarator.
+func AsAnyFieldDeclarator(n treesitter.Node) (AnyFieldDeclarator, bool) {
+	switch n.Type() {
+	case "array_declarator", "attributed_declarator", "field_identifier", "function_declarator", "parenthesized_declarator", "pointer_declarator":
+		return AnyFieldDeclarator{n}, n.IsNamed()
+	}
+	return AnyFieldDeclarator{n}, false
+}
+
+// TypeDeclarator wraps nodes of any of the subtypes of _type_declarator.
+type TypeDeclarator struct{ treesitter.Node }
+
+// AsTypeDeclarator converts n to TypeDeclarator, reporting whether it is of a subtype of _type_declarator.
+func AsTypeDeclarator(n treesitter.Node) (TypeDeclarator, bool) {
+	switch n.Type() {
+	case "array_declarator", "attributed_declarator", "function_declarator", "parenthesized_declarator", "pointer_declarator", "primitive_type", "type_identifier":
+		return TypeDeclarator{n}, n.IsNamed()
+	}
+	return TypeDeclarator{n}, false
+}
+
+// Expression wraps nodes of any of the subtypes of expression.
+type Expression struct{ treesitter.Node }
+
+// AsExpression converts n to Expression, reporting whether it is of a subtype of expression.
+func AsExpression(n treesitter.Node) (Expression, bool) {
+	switch n.Type() {
+	case "alignof_expression", "assignment_expression", "binary_expression", "call_expression", "cast_expression", "char_literal", "compound_literal_expression", "concatenated_string", "conditional_expression", "false", "field_expression", "generic_expression", "gnu_asm_expression", "identifier", "null", "number_literal", "offsetof_expression", "parenthesized_expression", "pointer_expression", "sizeof_expression", "string_literal", "subscript_expression", "true", "unary_expression", "update_expression":
+		return Expression{n}, n.IsNamed()
+	}
+	return Expression{n}, false
+}
+
+// Statement wraps nodes of any of the subtypes of statement.
+type Statement struct{ treesitter.Node }
+
+// AsStatement converts n to Statement, reporting whether it is of a subtype of statement.
+func AsStatement(n treesitter.Node) (Statement, bool) {
+	switch n.Type() {
+	case "attributed_statement", "break_statement", "case_statement", "compound_statement", "continue_statement", "do_statement", "expression_statement", "for_statement", "goto_statement", "if_statement", "labeled_statement", "return_statement", "seh_leave_statement", "seh_try_statement", "switch_statement", "while_statement":
+		return Statement{n}, n.IsNamed()
+	}
+	return Statement{n}, false
+}
+
+// TypeSpecifier wraps nodes of any of the subtypes of type_specifier.
+type TypeSpecifier struct{ treesitter.Node }
+
+// AsTypeSpecifier converts n to TypeSpecifier, reporting whether it is of a subtype of type_specifier.
+func AsTypeSpecifier(n treesitter.Node) (TypeSpecifier, bool) {
+	switch n.Type() {
+	case "enum_specifier", "macro_type_specifier", "primitive_type", "sized_type_specifier", "struct_specifier", "type_identifier", "union_specifier":
+		return TypeSpecifier{n}, n.IsNamed()
+	}
+	return TypeSpecifier{n}, false
+}
+
+// AbstractArrayDeclarator wraps nodes of type abstract_array_declarator.
+type AbstractArrayDeclarator struct{ treesitter.Node }
+
+// AsAbstractArrayDeclarator converts n to AbstractArrayDeclarator, reporting whether it is of type abstract_array_declarator.
+func AsAbstractArrayDeclarator(n treesitter.Node) (AbstractArrayDeclarator, bool) {
+	return AbstractArrayDeclarator{n}, n.IsNamed() && n.Type() == "abstract_array_declarator"
+}
+
+// Declarator returns the node in the declarator field.
+func (n AbstractArrayDeclarator) Declarator() AbstractDeclarator {
+	return AbstractDeclarator{n.ChildByFieldName("declarator")}
+}
+
+// Size returns the node in the size field.
+func (n AbstractArrayDeclarator) Size() treesitter.Node {
+	return n.ChildByFieldName("size")
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n AbstractArrayDeclarator) Contents() []TypeQualifier {
+	var nodes []TypeQualifier
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, TypeQualifier{c})
+		}
+	}
+	return nodes
+}
+
+// AbstractFunctionDeclarator wraps nodes of type abstract_function_declarator.
+type AbstractFunctionDeclarator struct{ treesitter.Node }
+
+// AsAbstractFunctionDeclarator converts n to AbstractFunctionDeclarator, reporting whether it is of type abstract_function_declarator.
+func AsAbstractFunctionDeclarator(n treesitter.Node) (AbstractFunctionDeclarator, bool) {
+	return AbstractFunctionDeclarator{n}, n.IsNamed() && n.Type() == "abstract_function_declarator"
+}
+
+// Declarator returns the node in the declarator field.
+func (n AbstractFunctionDeclarator) Declarator() AbstractDeclarator {
+	return AbstractDeclarator{n.ChildByFieldName("declarator")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n AbstractFunctionDeclarator) Parameters() ParameterList {
+	return ParameterList{n.ChildByFieldName("parameters")}
+}
+
+// AbstractParenthesizedDeclarator wraps nodes of type abstract_parenthesized_declarator.
+type AbstractParenthesizedDeclarator struct{ treesitter.Node }
+
+// AsAbstractParenthesizedDeclarator converts n to AbstractParenthesizedDeclarator, reporting whether it is of type abstract_parenthesized_declarator.
+func AsAbstractParenthesizedDeclarator(n treesitter.Node) (AbstractParenthesizedDeclarator, bool) {
+	return AbstractParenthesizedDeclarator{n}, n.IsNamed() && n.Type() == "abstract_parenthesized_declarator"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n AbstractParenthesizedDeclarator) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// AbstractPointerDeclarator wraps nodes of type abstract_pointer_declarator.
+type AbstractPointerDeclarator struct{ treesitter.Node }
+
+// AsAbstractPointerDeclarator converts n to AbstractPointerDeclarator, reporting whether it is of type abstract_pointer_declarator.
+func AsAbstractPointerDeclarator(n treesitter.Node) (AbstractPointerDeclarator, bool) {
+	return AbstractPointerDeclarator{n}, n.IsNamed() && n.Type() == "abstract_pointer_declarator"
+}
+
+// Declarator returns the node in the declarator field.
+func (n AbstractPointerDeclarator) Declarator() AbstractDeclarator {
+	return AbstractDeclarator{n.ChildByFieldName("declarator")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n AbstractPointerDeclarator) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// AlignasQualifier wraps nodes of type alignas_qualifier.
+type AlignasQualifier struct{ treesitter.Node }
+
+// AsAlignasQualifier converts n to AlignasQualifier, reporting whether it is of type alignas_qualifier.
+func AsAlignasQualifier(n treesitter.Node) (AlignasQualifier, bool) {
+	return AlignasQualifier{n}, n.IsNamed() && n.Type() == "alignas_qualifier"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n AlignasQualifier) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// AlignofExpression wraps nodes of type alignof_expression.
+type AlignofExpression struct{ treesitter.Node }
+
+// AsAlignofExpression converts n to AlignofExpression, reporting whether it is of type alignof_expression.
+func AsAlignofExpression(n treesitter.Node) (AlignofExpression, bool) {
+	return AlignofExpression{n}, n.IsNamed() && n.Type() == "alignof_expression"
+}
+
+// TypeField returns the node in the type field.
+func (n AlignofExpression) TypeField() TypeDescriptor {
+	return TypeDescriptor{n.ChildByFieldName("type")}
+}
+
+// ArgumentList wraps nodes of type argument_list.
+type ArgumentList struct{ treesitter.Node }
+
+// AsArgumentList converts n to ArgumentList, reporting whether it is of type argument_list.
+func AsArgumentList(n treesitter.Node) (ArgumentList, bool) {
+	return ArgumentList{n}, n.IsNamed() && n.Type() == "argument_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ArgumentList) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ArrayDeclarator wraps nodes of type array_declarator.
+type ArrayDeclarator struct{ treesitter.Node }
+
+// AsArrayDeclarator converts n to ArrayDeclarator, reporting whether it is of type array_declarator.
+func AsArrayDeclarator(n treesitter.Node) (ArrayDeclarator, bool) {
+	return ArrayDeclarator{n}, n.IsNamed() && n.Type() == "array_declarator"
+}
+
+// Declarator returns the node in the declarator field.
+func (n ArrayDeclarator) Declarator() treesitter.Node {
+	return n.ChildByFieldName("declarator")
+}
+
+// Size returns the node in the size field.
+func (n ArrayDeclarator) Size() treesitter.Node {
+	return n.ChildByFieldName("size")
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ArrayDeclarator) Contents() []TypeQualifier {
+	var nodes []TypeQualifier
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, TypeQualifier{c})
+		}
+	}
+	return nodes
+}
+
+// AssignmentExpression wraps nodes of type assignment_expression.
+type AssignmentExpression struct{ treesitter.Node }
+
+// AsAssignmentExpression converts n to AssignmentExpression, reporting whether it is of type assignment_expression.
+func AsAssignmentExpression(n treesitter.Node) (AssignmentExpression, bool) {
+	return AssignmentExpression{n}, n.IsNamed() && n.Type() == "assignment_expression"
+}
+
+// Left returns the node in the left field.
+func (n AssignmentExpression) Left() treesitter.Node {
+	return n.ChildByFieldName("left")
+}
+
+// Operator returns the node in the operator field.
+func (n AssignmentExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// Right returns the node in the right field.
+func (n AssignmentExpression) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// Attribute wraps nodes of type attribute.
+type Attribute struct{ treesitter.Node }
+
+// AsAttribute converts n to Attribute, reporting whether it is of type attribute.
+func AsAttribute(n treesitter.Node) (Attribute, bool) {
+	return Attribute{n}, n.IsNamed() && n.Type() == "attribute"
+}
+
+// Name returns the node in the name field.
+func (n Attribute) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Prefix returns the node in the prefix field.
+func (n Attribute) Prefix() Identifier {
+	return Identifier{n.ChildByFieldName("prefix")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n Attribute) Content() ArgumentList {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return ArgumentList{c}
+		}
+	}
+	return ArgumentList{}
+}
+
+// AttributeDeclaration wraps nodes of type attribute_declaration.
+type AttributeDeclaration struct{ treesitter.Node }
+
+// AsAttributeDeclaration converts n to AttributeDeclaration, reporting whether it is of type attribute_declaration.
+func AsAttributeDeclaration(n treesitter.Node) (AttributeDeclaration, bool) {
+	return AttributeDeclaration{n}, n.IsNamed() && n.Type() == "attribute_declaration"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n AttributeDeclaration) Contents() []Attribute {
+	var nodes []Attribute
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, Attribute{c})
+		}
+	}
+	return nodes
+}
+
+// AttributeSpecifier wraps nodes of type attribute_specifier.
+type AttributeSpecifier struct{ treesitter.Node }
+
+// AsAttributeSpecifier converts n to AttributeSpecifier, reporting whether it is of type attribute_specifier.
+func AsAttributeSpecifier(n treesitter.Node) (AttributeSpecifier, bool) {
+	return AttributeSpecifier{n}, n.IsNamed() && n.Type() == "attribute_specifier"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n AttributeSpecifier) Content() ArgumentList {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return ArgumentList{c}
+		}
+	}
+	return ArgumentList{}
+}
+
+// AttributedDeclarator wraps nodes of type attributed_declarator.
+type AttributedDeclarator struct{ treesitter.Node }
+
+// AsAttributedDeclarator converts n to AttributedDeclarator, reporting whether it is of type attributed_declarator.
+func AsAttributedDeclarator(n treesitter.Node) (AttributedDeclarator, bool) {
+	return AttributedDeclarator{n}, n.IsNamed() && n.Type() == "attributed_declarator"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n AttributedDeclarator) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// AttributedStatement wraps nodes of type attributed_statement.
+type AttributedStatement struct{ treesitter.Node }
+
+// AsAttributedStatement converts n to AttributedStatement, reporting whether it is of type attributed_statement.
+func AsAttributedStatement(n treesitter.Node) (AttributedStatement, bool) {
+	return AttributedStatement{n}, n.IsNamed() && n.Type() == "attributed_statement"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n AttributedStatement) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// BinaryExpression wraps nodes of type binary_expression.
+type BinaryExpression struct{ treesitter.Node }
+
+// AsBinaryExpression converts n to BinaryExpression, reporting whether it is of type binary_expression.
+func AsBinaryExpression(n treesitter.Node) (BinaryExpression, bool) {
+	return BinaryExpression{n}, n.IsNamed() && n.Type() == "binary_expression"
+}
+
+// Left returns the node in the left field.
+func (n BinaryExpression) Left() treesitter.Node {
+	return n.ChildByFieldName("left")
+}
+
+// Operator returns the node in the operator field.
+func (n BinaryExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// Right returns the node in the right field.
+func (n BinaryExpression) Right() treesitter.Node {
+	return n.ChildByFieldName("right")
+}
+
+// BitfieldClause wraps nodes of type bitfield_clause.
+type BitfieldClause struct{ treesitter.Node }
+
+// AsBitfieldClause converts n to BitfieldClause, reporting whether it is of type bitfield_clause.
+func AsBitfieldClause(n treesitter.Node) (BitfieldClause, bool) {
+	return BitfieldClause{n}, n.IsNamed() && n.Type() == "bitfield_clause"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n BitfieldClause) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// BreakStatement wraps nodes of type break_statement.
+type BreakStatement struct{ treesitter.Node }
+
+// AsBreakStatement converts n to BreakStatement, reporting whether it is of type break_statement.
+func AsBreakStatement(n treesitter.Node) (BreakStatement, bool) {
+	return BreakStatement{n}, n.IsNamed() && n.Type() == "break_statement"
+}
+
+// CallExpression wraps nodes of type call_expression.
+type CallExpression struct{ treesitter.Node }
+
+// AsCallExpression converts n to CallExpression, reporting whether it is of type call_expression.
+func AsCallExpression(n treesitter.Node) (CallExpression, bool) {
+	return CallExpression{n}, n.IsNamed() && n.Type() == "call_expression"
+}
+
+// Arguments returns the node in the arguments field.
+func (n CallExpression) Arguments() ArgumentList {
+	return ArgumentList{n.ChildByFieldName("arguments")}
+}
+
+// Function returns the node in the function field.
+func (n CallExpression) Function() treesitter.Node {
+	return n.ChildByFieldName("function")
+}
+
+// CaseStatement wraps nodes of type case_statement.
+type CaseStatement struct{ treesitter.Node }
+
+// AsCaseStatement converts n to CaseStatement, reporting whether it is of type case_statement.
+func AsCaseStatement(n treesitter.Node) (CaseStatement, bool) {
+	return CaseStatement{n}, n.IsNamed() && n.Type() == "case_statement"
+}
+
+// Value returns the node in the value field.
+func (n CaseStatement) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n CaseStatement) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// CastExpression wraps nodes of type cast_expression.
+type CastExpression struct{ treesitter.Node }
+
+// AsCastExpression converts n to CastExpression, reporting whether it is of type cast_expression.
+func AsCastExpression(n treesitter.Node) (CastExpression, bool) {
+	return CastExpression{n}, n.IsNamed() && n.Type() == "cast_expression"
+}
+
+// TypeField returns the node in the type field.
+func (n CastExpression) TypeField() TypeDescriptor {
+	return TypeDescriptor{n.ChildByFieldName("type")}
+}
+
+// Value returns the node in the value field.
+func (n CastExpression) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// CharLiteral wraps nodes of type char_literal.
+type CharLiteral struct{ treesitter.Node }
+
+// AsCharLiteral converts n to CharLiteral, reporting whether it is of type char_literal.
+func AsCharLiteral(n treesitter.Node) (CharLiteral, bool) {
+	return CharLiteral{n}, n.IsNamed() && n.Type() == "char_literal"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n CharLiteral) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// CommaExpression wraps nodes of type comma_expression.
+type CommaExpression struct{ treesitter.Node }
+
+// AsCommaExpression converts n to CommaExpression, reporting whether it is of type comma_expression.
+func AsCommaExpression(n treesitter.Node) (CommaExpression, bool) {
+	return CommaExpression{n}, n.IsNamed() && n.Type() == "comma_expression"
+}
+
+// Left returns the node in the left field.
+func (n CommaExpression) Left() Expression {
+	return Expression{n.ChildByFieldName("left")}
+}
+
+// Right returns the node in the right field.
+func (n CommaExpression) Right() treesitter.Node {
+	return n.ChildByFieldName("right")
+}
+
+// CompoundLiteralExpression wraps nodes of type compound_literal_expression.
+type CompoundLiteralExpression struct{ treesitter.Node }
+
+// AsCompoundLiteralExpression converts n to CompoundLiteralExpression, reporting whether it is of type compound_literal_expression.
+func AsCompoundLiteralExpression(n treesitter.Node) (CompoundLiteralExpression, bool) {
+	return CompoundLiteralExpression{n}, n.IsNamed() && n.Type() == "compound_literal_expression"
+}
+
+// TypeField returns the node in the type field.
+func (n CompoundLiteralExpression) TypeField() TypeDescriptor {
+	return TypeDescriptor{n.ChildByFieldName("type")}
+}
+
+// Value returns the node in the value field.
+func (n CompoundLiteralExpression) Value() InitializerList {
+	return InitializerList{n.ChildByFieldName("value")}
+}
+
+// CompoundStatement wraps nodes of type compound_statement.
+type CompoundStatement struct{ treesitter.Node }
+
+// AsCompoundStatement converts n to CompoundStatement, reporting whether it is of type compound_statement.
+func AsCompoundStatement(n treesitter.Node) (CompoundStatement, bool) {
+	return CompoundStatement{n}, n.IsNamed() && n.Type() == "compound_statement"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n CompoundStatement) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ConcatenatedString wraps nodes of type concatenated_string.
+type ConcatenatedString struct{ treesitter.Node }
+
+// AsConcatenatedString converts n to ConcatenatedString, reporting whether it is of type concatenated_string.
+func AsConcatenatedString(n treesitter.Node) (ConcatenatedString, bool) {
+	return ConcatenatedString{n}, n.IsNamed() && n.Type() == "concatenated_string"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ConcatenatedString) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ConditionalExpression wraps nodes of type conditional_expression.
+type ConditionalExpression struct{ treesitter.Node }
+
+// AsConditionalExpression converts n to ConditionalExpression, reporting whether it is of type conditional_expression.
+func AsConditionalExpression(n treesitter.Node) (ConditionalExpression, bool) {
+	return ConditionalExpression{n}, n.IsNamed() && n.Type() == "conditional_expression"
+}
+
+// Alternative returns the node in the alternative field.
+func (n ConditionalExpression) Alternative() Expression {
+	return Expression{n.ChildByFieldName("alternative")}
+}
+
+// Condition returns the node in the condition field.
+func (n ConditionalExpression) Condition() Expression {
+	return Expression{n.ChildByFieldName("condition")}
+}
+
+// Consequence returns the node in the consequence field.
+func (n ConditionalExpression) Consequence() treesitter.Node {
+	return n.ChildByFieldName("consequence")
+}
+
+// ContinueStatement wraps nodes of type continue_statement.
+type ContinueStatement struct{ treesitter.Node }
+
+// AsContinueStatement converts n to ContinueStatement, reporting whether it is of type continue_statement.
+func AsContinueStatement(n treesitter.Node) (ContinueStatement, bool) {
+	return ContinueStatement{n}, n.IsNamed() && n.Type() == "continue_statement"
+}
+
+// Declaration wraps nodes of type declaration.
+type Declaration struct{ treesitter.Node }
+
+// AsDeclaration converts n to Declaration, reporting whether it is of type declaration.
+func AsDeclaration(n treesitter.Node) (Declaration, bool) {
+	return Declaration{n}, n.IsNamed() && n.Type() == "declaration"
+}
+
+// Declarator returns the nodes in the declarator field.
+func (n Declaration) Declarator() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "declarator" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeField returns the node in the type field.
+func (n Declaration) TypeField() TypeSpecifier {
+	return TypeSpecifier{n.ChildByFieldName("type")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n Declaration) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// DeclarationList wraps nodes of type declaration_list.
+type DeclarationList struct{ treesitter.Node }
+
+// AsDeclarationList converts n to DeclarationList, reporting whether it is of type declaration_list.
+func AsDeclarationList(n treesitter.Node) (DeclarationList, bool) {
+	return DeclarationList{n}, n.IsNamed() && n.Type() == "declaration_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n DeclarationList) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// DoStatement wraps nodes of type do_statement.
+type DoStatement struct{ treesitter.Node }
+
+// AsDoStatement converts n to DoStatement, reporting whether it is of type do_statement.
+func AsDoStatement(n treesitter.Node) (DoStatement, bool) {
+	return DoStatement{n}, n.IsNamed() && n.Type() == "do_statement"
+}
+
+// Body returns the node in the body field.
+func (n DoStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Condition returns the node in the condition field.
+func (n DoStatement) Condition() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("condition")}
+}
+
+// ElseClause wraps nodes of type else_clause.
+type ElseClause struct{ treesitter.Node }
+
+// AsElseClause converts n to ElseClause, reporting whether it is of type else_clause.
+func AsElseClause(n treesitter.Node) (ElseClause, bool) {
+	return ElseClause{n}, n.IsNamed() && n.Type() == "else_clause"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ElseClause) Content() Statement {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Statement{c}
+		}
+	}
+	return Statement{}
+}
+
+// EnumSpecifier wraps nodes of type enum_specifier.
+type EnumSpecifier struct{ treesitter.Node }
+
+// AsEnumSpecifier converts n to EnumSpecifier, reporting whether it is of type enum_specifier.
+func AsEnumSpecifier(n treesitter.Node) (EnumSpecifier, bool) {
+	return EnumSpecifier{n}, n.IsNamed() && n.Type() == "enum_specifier"
+}
+
+// Body returns the node in the body field.
+func (n EnumSpecifier) Body() EnumeratorList {
+	return EnumeratorList{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n EnumSpecifier) Name() TypeIdentifier {
+	return TypeIdentifier{n.ChildByFieldName("name")}
+}
+
+// UnderlyingType returns the node in the underlying_type field.
+func (n EnumSpecifier) UnderlyingType() PrimitiveType {
+	return PrimitiveType{n.ChildByFieldName("underlying_type")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n EnumSpecifier) Content() AttributeSpecifier {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return AttributeSpecifier{c}
+		}
+	}
+	return AttributeSpecifier{}
+}
+
+// Enumerator wraps nodes of type enumerator.
+type Enumerator struct{ treesitter.Node }
+
+// AsEnumerator converts n to Enumerator, reporting whether it is of type enumerator.
+func AsEnumerator(n treesitter.Node) (Enumerator, bool) {
+	return Enumerator{n}, n.IsNamed() && n.Type() == "enumerator"
+}
+
+// Name returns the node in the name field.
+func (n Enumerator) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Value returns the node in the value field.
+func (n Enumerator) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// EnumeratorList wraps nodes of type enumerator_list.
+type EnumeratorList struct{ treesitter.Node }
+
+// AsEnumeratorList converts n to EnumeratorList, reporting whether it is of type enumerator_list.
+func AsEnumeratorList(n treesitter.Node) (EnumeratorList, bool) {
+	return EnumeratorList{n}, n.IsNamed() && n.Type() == "enumerator_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n EnumeratorList) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ExpressionStatement wraps nodes of type expression_statement.
+type ExpressionStatement struct{ treesitter.Node }
+
+// AsExpressionStatement converts n to ExpressionStatement, reporting whether it is of type expression_statement.
+func AsExpressionStatement(n treesitter.Node) (ExpressionStatement, bool) {
+	return ExpressionStatement{n}, n.IsNamed() && n.Type() == "expression_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ExpressionStatement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// FieldDeclaration wraps nodes of type field_declaration.
+type FieldDeclaration struct{ treesitter.Node }
+
+// AsFieldDeclaration converts n to FieldDeclaration, reporting whether it is of type field_declaration.
+func AsFieldDeclaration(n treesitter.Node) (FieldDeclaration, bool) {
+	return FieldDeclaration{n}, n.IsNamed() && n.Type() == "field_declaration"
+}
+
+// Declarator returns the nodes in the declarator field.
+func (n FieldDeclaration) Declarator() []AnyFieldDeclarator {
+	var nodes []AnyFieldDeclarator
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "declarator" {
+			nodes = append(nodes, AnyFieldDeclarator{c})
+		}
+	}
+	return nodes
+}
+
+// TypeField returns the node in the type field.
+func (n FieldDeclaration) TypeField() TypeSpecifier {
+	return TypeSpecifier{n.ChildByFieldName("type")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n FieldDeclaration) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// FieldDeclarationList wraps nodes of type field_declaration_list.
+type FieldDeclarationList struct{ treesitter.Node }
+
+// AsFieldDeclarationList converts n to FieldDeclarationList, reporting whether it is of type field_declaration_list.
+func AsFieldDeclarationList(n treesitter.Node) (FieldDeclarationList, bool) {
+	return FieldDeclarationList{n}, n.IsNamed() && n.Type() == "field_declaration_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n FieldDeclarationList) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// FieldDesignator2 wraps nodes of type field_designator.
+type FieldDesignator2 struct{ treesitter.Node }
+
+// AsFieldDesignator2 converts n to FieldDesignator2, reporting whether it is of type field_designator.
+func AsFieldDesignator2(n treesitter.Node) (FieldDesignator2, bool) {
+	return FieldDesignator2{n}, n.IsNamed() && n.Type() == "field_designator"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n FieldDesignator2) Content() FieldIdentifier {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return FieldIdentifier{c}
+		}
+	}
+	return FieldIdentifier{}
+}
+
+// FieldExpression wraps nodes of type field_expression.
+type FieldExpression struct{ treesitter.Node }
+
+// AsFieldExpression converts n to FieldExpression, reporting whether it is of type field_expression.
+func AsFieldExpression(n treesitter.Node) (FieldExpression, bool) {
+	return FieldExpression{n}, n.IsNamed() && n.Type() == "field_expression"
+}
+
+// Argument returns the node in the argument field.
+func (n FieldExpression) Argument() Expression {
+	return Expression{n.ChildByFieldName("argument")}
+}
+
+// Field returns the node in the field field.
+func (n FieldExpression) Field() FieldIdentifier {
+	return FieldIdentifier{n.ChildByFieldName("field")}
+}
+
+// Operator returns the node in the operator field.
+func (n FieldExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// ForStatement wraps nodes of type for_statement.
+type ForStatement struct{ treesitter.Node }
+
+// AsForStatement converts n to ForStatement, reporting whether it is of type for_statement.
+func AsForStatement(n treesitter.Node) (ForStatement, bool) {
+	return ForStatement{n}, n.IsNamed() && n.Type() == "for_statement"
+}
+
+// Body returns the node in the body field.
+func (n ForStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Condition returns the node in the condition field.
+func (n ForStatement) Condition() treesitter.Node {
+	return n.ChildByFieldName("condition")
+}
+
+// Initializer returns the node in the initializer field.
+func (n ForStatement) Initializer() treesitter.Node {
+	return n.ChildByFieldName("initializer")
+}
+
+// Update returns the node in the update field.
+func (n ForStatement) Update() treesitter.Node {
+	return n.ChildByFieldName("update")
+}
+
+// FunctionDeclarator wraps nodes of type function_declarator.
+type FunctionDeclarator struct{ treesitter.Node }
+
+// AsFunctionDeclarator converts n to FunctionDeclarator, reporting whether it is of type function_declarator.
+func AsFunctionDeclarator(n treesitter.Node) (FunctionDeclarator, bool) {
+	return FunctionDeclarator{n}, n.IsNamed() && n.Type() == "function_declarator"
+}
+
+// Declarator returns the node in the declarator field.
+func (n FunctionDeclarator) Declarator() treesitter.Node {
+	return n.ChildByFieldName("declarator")
+}
+
+// Parameters returns the node in the parameters field.
+func (n FunctionDeclarator) Parameters() ParameterList {
+	return ParameterList{n.ChildByFieldName("parameters")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n FunctionDeclarator) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// FunctionDefinition wraps nodes of type function_definition.
+type FunctionDefinition struct{ treesitter.Node }
+
+// AsFunctionDefinition converts n to FunctionDefinition, reporting whether it is of type function_definition.
+func AsFunctionDefinition(n treesitter.Node) (FunctionDefinition, bool) {
+	return FunctionDefinition{n}, n.IsNamed() && n.Type() == "function_definition"
+}
+
+// Body returns the node in the body field.
+func (n FunctionDefinition) Body() CompoundStatement {
+	return CompoundStatement{n.ChildByFieldName("body")}
+}
+
+// Declarator returns the node in the declarator field.
+func (n FunctionDefinition) Declarator() treesitter.Node {
+	return n.ChildByFieldName("declarator")
+}
+
+// TypeField returns the node in the type field.
+func (n FunctionDefinition) TypeField() TypeSpecifier {
+	return TypeSpecifier{n.ChildByFieldName("type")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n FunctionDefinition) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// GenericExpression wraps nodes of type generic_expression.
+type GenericExpression struct{ treesitter.Node }
+
+// AsGenericExpression converts n to GenericExpression, reporting whether it is of type generic_expression.
+func AsGenericExpression(n treesitter.Node) (GenericExpression, bool) {
+	return GenericExpression{n}, n.IsNamed() && n.Type() == "generic_expression"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n GenericExpression) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// GnuAsmClobberList wraps nodes of type gnu_asm_clobber_list.
+type GnuAsmClobberList struct{ treesitter.Node }
+
+// AsGnuAsmClobberList converts n to GnuAsmClobberList, reporting whether it is of type gnu_asm_clobber_list.
+func AsGnuAsmClobberList(n treesitter.Node) (GnuAsmClobberList, bool) {
+	return GnuAsmClobberList{n}, n.IsNamed() && n.Type() == "gnu_asm_clobber_list"
+}
+
+// Register returns the nodes in the register field.
+func (n GnuAsmClobberList) Register() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "register" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// GnuAsmExpression wraps nodes of type gnu_asm_expression.
+type GnuAsmExpression struct{ treesitter.Node }
+
+// AsGnuAsmExpression converts n to GnuAsmExpression, reporting whether it is of type gnu_asm_expression.
+func AsGnuAsmExpression(n treesitter.Node) (GnuAsmExpression, bool) {
+	return GnuAsmExpression{n}, n.IsNamed() && n.Type() == "gnu_asm_expression"
+}
+
+// AssemblyCode returns the node in the assembly_code field.
+func (n GnuAsmExpression) AssemblyCode() treesitter.Node {
+	return n.ChildByFieldName("assembly_code")
+}
+
+// Clobbers returns the node in the clobbers field.
+func (n GnuAsmExpression) Clobbers() GnuAsmClobberList {
+	return GnuAsmClobberList{n.ChildByFieldName("clobbers")}
+}
+
+// GotoLabels returns the node in the goto_labels field.
+func (n GnuAsmExpression) GotoLabels() GnuAsmGotoList {
+	return GnuAsmGotoList{n.ChildByFieldName("goto_labels")}
+}
+
+// InputOperands returns the node in the input_operands field.
+func (n GnuAsmExpression) InputOperands() GnuAsmInputOperandList {
+	return GnuAsmInputOperandList{n.ChildByFieldName("input_operands")}
+}
+
+// OutputOperands returns the node in the output_operands field.
+func (n GnuAsmExpression) OutputOperands() GnuAsmOutputOperandList {
+	return GnuAsmOutputOperandList{n.ChildByFieldName("output_operands")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n GnuAsmExpression) Contents() []GnuAsmQualifier {
+	var nodes []GnuAsmQualifier
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, GnuAsmQualifier{c})
+		}
+	}
+	return nodes
+}
+
+// GnuAsmGotoList wraps nodes of type gnu_asm_goto_list.
+type GnuAsmGotoList struct{ treesitter.Node }
+
+// AsGnuAsmGotoList converts n to GnuAsmGotoList, reporting whether it is of type gnu_asm_goto_list.
+func AsGnuAsmGotoList(n treesitter.Node) (GnuAsmGotoList, bool) {
+	return GnuAsmGotoList{n}, n.IsNamed() && n.Type() == "gnu_asm_goto_list"
+}
+
+// Label returns the nodes in the label field.
+func (n GnuAsmGotoList) Label() []Identifier {
+	var nodes []Identifier
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "label" {
+			nodes = append(nodes, Identifier{c})
+		}
+	}
+	return nodes
+}
+
+// GnuAsmInputOperand wraps nodes of type gnu_asm_input_operand.
+type GnuAsmInputOperand struct{ treesitter.Node }
+
+// AsGnuAsmInputOperand converts n to GnuAsmInputOperand, reporting whether it is of type gnu_asm_input_operand.
+func AsGnuAsmInputOperand(n treesitter.Node) (GnuAsmInputOperand, bool) {
+	return GnuAsmInputOperand{n}, n.IsNamed() && n.Type() == "gnu_asm_input_operand"
+}
+
+// Constraint returns the node in the constraint field.
+func (n GnuAsmInputOperand) Constraint() StringLiteral {
+	return StringLiteral{n.ChildByFieldName("constraint")}
+}
+
+// SymbolField returns the node in the symbol field.
+func (n GnuAsmInputOperand) SymbolField() Identifier {
+	return Identifier{n.ChildByFieldName("symbol")}
+}
+
+// Value returns the node in the value field.
+func (n GnuAsmInputOperand) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// GnuAsmInputOperandList wraps nodes of type gnu_asm_input_operand_list.
+type GnuAsmInputOperandList struct{ treesitter.Node }
+
+// AsGnuAsmInputOperandList converts n to GnuAsmInputOperandList, reporting whether it is of type gnu_asm_input_operand_list.
+func AsGnuAsmInputOperandList(n treesitter.Node) (GnuAsmInputOperandList, bool) {
+	return GnuAsmInputOperandList{n}, n.IsNamed() && n.Type() == "gnu_asm_input_operand_list"
+}
+
+// Operand returns the nodes in the operand field.
+func (n GnuAsmInputOperandList) Operand() []GnuAsmInputOperand {
+	var nodes []GnuAsmInputOperand
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "operand" {
+			nodes = append(nodes, GnuAsmInputOperand{c})
+		}
+	}
+	return nodes
+}
+
+// GnuAsmOutputOperand wraps nodes of type gnu_asm_output_operand.
+type GnuAsmOutputOperand struct{ treesitter.Node }
+
+// AsGnuAsmOutputOperand converts n to GnuAsmOutputOperand, reporting whether it is of type gnu_asm_output_operand.
+func AsGnuAsmOutputOperand(n treesitter.Node) (GnuAsmOutputOperand, bool) {
+	return GnuAsmOutputOperand{n}, n.IsNamed() && n.Type() == "gnu_asm_output_operand"
+}
+
+// Constraint returns the node in the constraint field.
+func (n GnuAsmOutputOperand) Constraint() StringLiteral {
+	return StringLiteral{n.ChildByFieldName("constraint")}
+}
+
+// SymbolField returns the node in the symbol field.
+func (n GnuAsmOutputOperand) SymbolField() Identifier {
+	return Identifier{n.ChildByFieldName("symbol")}
+}
+
+// Value returns the node in the value field.
+func (n GnuAsmOutputOperand) Value() Identifier {
+	return Identifier{n.ChildByFieldName("value")}
+}
+
+// GnuAsmOutputOperandList wraps nodes of type gnu_asm_output_operand_list.
+type GnuAsmOutputOperandList struct{ treesitter.Node }
+
+// AsGnuAsmOutputOperandList converts n to GnuAsmOutputOperandList, reporting whether it is of type gnu_asm_output_operand_list.
+func AsGnuAsmOutputOperandList(n treesitter.Node) (GnuAsmOutputOperandList, bool) {
+	return GnuAsmOutputOperandList{n}, n.IsNamed() && n.Type() == "gnu_asm_output_operand_list"
+}
+
+// Operand returns the nodes in the operand field.
+func (n GnuAsmOutputOperandList) Operand() []GnuAsmOutputOperand {
+	var nodes []GnuAsmOutputOperand
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "operand" {
+			nodes = append(nodes, GnuAsmOutputOperand{c})
+		}
+	}
+	return nodes
+}
+
+// GnuAsmQualifier wraps nodes of type gnu_asm_qualifier.
+type GnuAsmQualifier struct{ treesitter.Node }
+
+// AsGnuAsmQualifier converts n to GnuAsmQualifier, reporting whether it is of type gnu_asm_qualifier.
+func AsGnuAsmQualifier(n treesitter.Node) (GnuAsmQualifier, bool) {
+	return GnuAsmQualifier{n}, n.IsNamed() && n.Type() == "gnu_asm_qualifier"
+}
+
+// GotoStatement wraps nodes of type goto_statement.
+type GotoStatement struct{ treesitter.Node }
+
+// AsGotoStatement converts n to GotoStatement, reporting whether it is of type goto_statement.
+func AsGotoStatement(n treesitter.Node) (GotoStatement, bool) {
+	return GotoStatement{n}, n.IsNamed() && n.Type() == "goto_statement"
+}
+
+// Label returns the node in the label field.
+func (n GotoStatement) Label() StatementIdentifier {
+	return StatementIdentifier{n.ChildByFieldName("label")}
+}
+
+// IfStatement wraps nodes of type if_statement.
+type IfStatement struct{ treesitter.Node }
+
+// AsIfStatement converts n to IfStatement, reporting whether it is of type if_statement.
+func AsIfStatement(n treesitter.Node) (IfStatement, bool) {
+	return IfStatement{n}, n.IsNamed() && n.Type() == "if_statement"
+}
+
+// Alternative returns the node in the alternative field.
+func (n IfStatement) Alternative() ElseClause {
+	return ElseClause{n.ChildByFieldName("alternative")}
+}
+
+// Condition returns the node in the condition field.
+func (n IfStatement) Condition() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("condition")}
+}
+
+// Consequence returns the node in the consequence field.
+func (n IfStatement) Consequence() Statement {
+	return Statement{n.ChildByFieldName("consequence")}
+}
+
+// InitDeclarator wraps nodes of type init_declarator.
+type InitDeclarator struct{ treesitter.Node }
+
+// AsInitDeclarator converts n to InitDeclarator, reporting whether it is of type init_declarator.
+func AsInitDeclarator(n treesitter.Node) (InitDeclarator, bool) {
+	return InitDeclarator{n}, n.IsNamed() && n.Type() == "init_declarator"
+}
+
+// Declarator returns the node in the declarator field.
+func (n InitDeclarator) Declarator() Declarator {
+	return Declarator{n.ChildByFieldName("declarator")}
+}
+
+// Value returns the node in the value field.
+func (n InitDeclarator) Value() treesitter.Node {
+	return n.ChildByFieldName("value")
+}
+
+// InitializerList wraps nodes of type initializer_list.
+type InitializerList struct{ treesitter.Node }
+
+// AsInitializerList converts n to InitializerList, reporting whether it is of type initializer_list.
+func AsInitializerList(n treesitter.Node) (InitializerList, bool) {
+	return InitializerList{n}, n.IsNamed() && n.Type() == "initializer_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n InitializerList) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// InitializerPair wraps nodes of type initializer_pair.
+type InitializerPair struct{ treesitter.Node }
+
+// AsInitializerPair converts n to InitializerPair, reporting whether it is of type initializer_pair.
+func AsInitializerPair(n treesitter.Node) (InitializerPair, bool) {
+	return InitializerPair{n}, n.IsNamed() && n.Type() == "initializer_pair"
+}
+
+// Designator returns the nodes in the designator field.
+func (n InitializerPair) Designator() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "designator" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// Value returns the node in the value field.
+func (n InitializerPair) Value() treesitter.Node {
+	return n.ChildByFieldName("value")
+}
+
+// LabeledStatement wraps nodes of type labeled_statement.
+type LabeledStatement struct{ treesitter.Node }
+
+// AsLabeledStatement converts n to LabeledStatement, reporting whether it is of type labeled_statement.
+func AsLabeledStatement(n treesitter.Node) (LabeledStatement, bool) {
+	return LabeledStatement{n}, n.IsNamed() && n.Type() == "labeled_statement"
+}
+
+// Label returns the node in the label field.
+func (n LabeledStatement) Label() StatementIdentifier {
+	return StatementIdentifier{n.ChildByFieldName("label")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n LabeledStatement) Content() Statement {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Statement{c}
+		}
+	}
+	return Statement{}
+}
+
+// LinkageSpecification wraps nodes of type linkage_specification.
+type LinkageSpecification struct{ treesitter.Node }
+
+// AsLinkageSpecification converts n to LinkageSpecification, reporting whether it is of type linkage_specification.
+func AsLinkageSpecification(n treesitter.Node) (LinkageSpecification, bool) {
+	return LinkageSpecification{n}, n.IsNamed() && n.Type() == "linkage_specification"
+}
+
+// Body returns the node in the body field.
+func (n LinkageSpecification) Body() treesitter.Node {
+	return n.ChildByFieldName("body")
+}
+
+// Value returns the node in the value field.
+func (n LinkageSpecification) Value() StringLiteral {
+	return StringLiteral{n.ChildByFieldName("value")}
+}
+
+// MacroTypeSpecifier wraps nodes of type macro_type_specifier.
+type MacroTypeSpecifier struct{ treesitter.Node }
+
+// AsMacroTypeSpecifier converts n to MacroTypeSpecifier, reporting whether it is of type macro_type_specifier.
+func AsMacroTypeSpecifier(n treesitter.Node) (MacroTypeSpecifier, bool) {
+	return MacroTypeSpecifier{n}, n.IsNamed() && n.Type() == "macro_type_specifier"
+}
+
+// Name returns the node in the name field.
+func (n MacroTypeSpecifier) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// TypeField returns the node in the type field.
+func (n MacroTypeSpecifier) TypeField() TypeDescriptor {
+	return TypeDescriptor{n.ChildByFieldName("type")}
+}
+
+// MsBasedModifier wraps nodes of type ms_based_modifier.
+type MsBasedModifier struct{ treesitter.Node }
+
+// AsMsBasedModifier converts n to MsBasedModifier, reporting whether it is of type ms_based_modifier.
+func AsMsBasedModifier(n treesitter.Node) (MsBasedModifier, bool) {
+	return MsBasedModifier{n}, n.IsNamed() && n.Type() == "ms_based_modifier"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n MsBasedModifier) Content() ArgumentList {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return ArgumentList{c}
+		}
+	}
+	return ArgumentList{}
+}
+
+// MsCallModifier wraps nodes of type ms_call_modifier.
+type MsCallModifier struct{ treesitter.Node }
+
+// AsMsCallModifier converts n to MsCallModifier, reporting whether it is of type ms_call_modifier.
+func AsMsCallModifier(n treesitter.Node) (MsCallModifier, bool) {
+	return MsCallModifier{n}, n.IsNamed() && n.Type() == "ms_call_modifier"
+}
+
+// MsDeclspecModifier wraps nodes of type ms_declspec_modifier.
+type MsDeclspecModifier struct{ treesitter.Node }
+
+// AsMsDeclspecModifier converts n to MsDeclspecModifier, reporting whether it is of type ms_declspec_modifier.
+func AsMsDeclspecModifier(n treesitter.Node) (MsDeclspecModifier, bool) {
+	return MsDeclspecModifier{n}, n.IsNamed() && n.Type() == "ms_declspec_modifier"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n MsDeclspecModifier) Content() Identifier {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Identifier{c}
+		}
+	}
+	return Identifier{}
+}
+
+// MsPointerModifier wraps nodes of type ms_pointer_modifier.
+type MsPointerModifier struct{ treesitter.Node }
+
+// AsMsPointerModifier converts n to MsPointerModifier, reporting whether it is of type ms_pointer_modifier.
+func AsMsPointerModifier(n treesitter.Node) (MsPointerModifier, bool) {
+	return MsPointerModifier{n}, n.IsNamed() && n.Type() == "ms_pointer_modifier"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n MsPointerModifier) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// MsUnalignedPtrModifier wraps nodes of type ms_unaligned_ptr_modifier.
+type MsUnalignedPtrModifier struct{ treesitter.Node }
+
+// AsMsUnalignedPtrModifier converts n to MsUnalignedPtrModifier, reporting whether it is of type ms_unaligned_ptr_modifier.
+func AsMsUnalignedPtrModifier(n treesitter.Node) (MsUnalignedPtrModifier, bool) {
+	return MsUnalignedPtrModifier{n}, n.IsNamed() && n.Type() == "ms_unaligned_ptr_modifier"
+}
+
+// Null wraps nodes of type null.
+type Null struct{ treesitter.Node }
+
+// AsNull converts n to Null, reporting whether it is of type null.
+func AsNull(n treesitter.Node) (Null, bool) {
+	return Null{n}, n.IsNamed() && n.Type() == "null"
+}
+
+// OffsetofExpression wraps nodes of type offsetof_expression.
+type OffsetofExpression struct{ treesitter.Node }
+
+// AsOffsetofExpression converts n to OffsetofExpression, reporting whether it is of type offsetof_expression.
+func AsOffsetofExpression(n treesitter.Node) (OffsetofExpression, bool) {
+	return OffsetofExpression{n}, n.IsNamed() && n.Type() == "offsetof_expression"
+}
+
+// Member returns the node in the member field.
+func (n OffsetofExpression) Member() FieldIdentifier {
+	return FieldIdentifier{n.ChildByFieldName("member")}
+}
+
+// TypeField returns the node in the type field.
+func (n OffsetofExpression) TypeField() TypeDescriptor {
+	return TypeDescriptor{n.ChildByFieldName("type")}
+}
+
+// ParameterDeclaration wraps nodes of type parameter_declaration.
+type ParameterDeclaration struct{ treesitter.Node }
+
+// AsParameterDeclaration converts n to ParameterDeclaration, reporting whether it is of type parameter_declaration.
+func AsParameterDeclaration(n treesitter.Node) (ParameterDeclaration, bool) {
+	return ParameterDeclaration{n}, n.IsNamed() && n.Type() == "parameter_declaration"
+}
+
+// Declarator returns the node in the declarator field.
+func (n ParameterDeclaration) Declarator() treesitter.Node {
+	return n.ChildByFieldName("declarator")
+}
+
+// TypeField returns the node in the type field.
+func (n ParameterDeclaration) TypeField() TypeSpecifier {
+	return TypeSpecifier{n.ChildByFieldName("type")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ParameterDeclaration) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ParameterList wraps nodes of type parameter_list.
+type ParameterList struct{ treesitter.Node }
+
+// AsParameterList converts n to ParameterList, reporting whether it is of type parameter_list.
+func AsParameterList(n treesitter.Node) (ParameterList, bool) {
+	return ParameterList{n}, n.IsNamed() && n.Type() == "parameter_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ParameterList) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ParenthesizedDeclarator wraps nodes of type parenthesized_declarator.
+type ParenthesizedDeclarator struct{ treesitter.Node }
+
+// AsParenthesizedDeclarator converts n to ParenthesizedDeclarator, reporting whether it is of type parenthesized_declarator.
+func AsParenthesizedDeclarator(n treesitter.Node) (ParenthesizedDeclarator, bool) {
+	return ParenthesizedDeclarator{n}, n.IsNamed() && n.Type() == "parenthesized_declarator"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ParenthesizedDeclarator) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ParenthesizedExpression wraps nodes of type parenthesized_expression.
+type ParenthesizedExpression struct{ treesitter.Node }
+
+// AsParenthesizedExpression converts n to ParenthesizedExpression, reporting whether it is of type parenthesized_expression.
+func AsParenthesizedExpression(n treesitter.Node) (ParenthesizedExpression, bool) {
+	return ParenthesizedExpression{n}, n.IsNamed() && n.Type() == "parenthesized_expression"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ParenthesizedExpression) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// PointerDeclarator wraps nodes of type pointer_declarator.
+type PointerDeclarator struct{ treesitter.Node }
+
+// AsPointerDeclarator converts n to PointerDeclarator, reporting whether it is of type pointer_declarator.
+func AsPointerDeclarator(n treesitter.Node) (PointerDeclarator, bool) {
+	return PointerDeclarator{n}, n.IsNamed() && n.Type() == "pointer_declarator"
+}
+
+// Declarator returns the node in the declarator field.
+func (n PointerDeclarator) Declarator() treesitter.Node {
+	return n.ChildByFieldName("declarator")
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n PointerDeclarator) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// PointerExpression wraps nodes of type pointer_expression.
+type PointerExpression struct{ treesitter.Node }
+
+// AsPointerExpression converts n to PointerExpression, reporting whether it is of type pointer_expression.
+func AsPointerExpression(n treesitter.Node) (PointerExpression, bool) {
+	return PointerExpression{n}, n.IsNamed() && n.Type() == "pointer_expression"
+}
+
+// Argument returns the node in the argument field.
+func (n PointerExpression) Argument() Expression {
+	return Expression{n.ChildByFieldName("argument")}
+}
+
+// Operator returns the node in the operator field.
+func (n PointerExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// PreprocCall wraps nodes of type preproc_call.
+type PreprocCall struct{ treesitter.Node }
+
+// AsPreprocCall converts n to PreprocCall, reporting whether it is of type preproc_call.
+func AsPreprocCall(n treesitter.Node) (PreprocCall, bool) {
+	return PreprocCall{n}, n.IsNamed() && n.Type() == "preproc_call"
+}
+
+// Argument returns the node in the argument field.
+func (n PreprocCall) Argument() PreprocArg {
+	return PreprocArg{n.ChildByFieldName("argument")}
+}
+
+// Directive returns the node in the directive field.
+func (n PreprocCall) Directive() PreprocDirective {
+	return PreprocDirective{n.ChildByFieldName("directive")}
+}
+
+// PreprocDef wraps nodes of type preproc_def.
+type PreprocDef struct{ treesitter.Node }
+
+// AsPreprocDef converts n to PreprocDef, reporting whether it is of type preproc_def.
+func AsPreprocDef(n treesitter.Node) (PreprocDef, bool) {
+	return PreprocDef{n}, n.IsNamed() && n.Type() == "preproc_def"
+}
+
+// Name returns the node in the name field.
+func (n PreprocDef) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Value returns the node in the value field.
+func (n PreprocDef) Value() PreprocArg {
+	return PreprocArg{n.ChildByFieldName("value")}
+}
+
+// PreprocDefined wraps nodes of type preproc_defined.
+type PreprocDefined struct{ treesitter.Node }
+
+// AsPreprocDefined converts n to PreprocDefined, reporting whether it is of type preproc_defined.
+func AsPreprocDefined(n treesitter.Node) (PreprocDefined, bool) {
+	return PreprocDefined{n}, n.IsNamed() && n.Type() == "preproc_defined"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n PreprocDefined) Content() Identifier {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Identifier{c}
+		}
+	}
+	return Identifier{}
+}
+
+// PreprocElif wraps nodes of type preproc_elif.
+type PreprocElif struct{ treesitter.Node }
+
+// AsPreprocElif converts n to PreprocElif, reporting whether it is of type preproc_elif.
+func AsPreprocElif(n treesitter.Node) (PreprocElif, bool) {
+	return PreprocElif{n}, n.IsNamed() && n.Type() == "preproc_elif"
+}
+
+// Alternative returns the node in the alternative field.
+func (n PreprocElif) Alternative() treesitter.Node {
+	return n.ChildByFieldName("alternative")
+}
+
+// Condition returns the node in the condition field.
+func (n PreprocElif) Condition() treesitter.Node {
+	return n.ChildByFieldName("condition")
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n PreprocElif) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// PreprocElifdef wraps nodes of type preproc_elifdef.
+type PreprocElifdef struct{ treesitter.Node }
+
+// AsPreprocElifdef converts n to PreprocElifdef, reporting whether it is of type preproc_elifdef.
+func AsPreprocElifdef(n treesitter.Node) (PreprocElifdef, bool) {
+	return PreprocElifdef{n}, n.IsNamed() && n.Type() == "preproc_elifdef"
+}
+
+// Alternative returns the node in the alternative field.
+func (n PreprocElifdef) Alternative() treesitter.Node {
+	return n.ChildByFieldName("alternative")
+}
+
+// Name returns the node in the name field.
+func (n PreprocElifdef) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n PreprocElifdef) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// PreprocElse wraps nodes of type preproc_else.
+type PreprocElse struct{ treesitter.Node }
+
+// AsPreprocElse converts n to PreprocElse, reporting whether it is of type preproc_else.
+func AsPreprocElse(n treesitter.Node) (PreprocElse, bool) {
+	return PreprocElse{n}, n.IsNamed() && n.Type() == "preproc_else"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n PreprocElse) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// PreprocFunctionDef wraps nodes of type preproc_function_def.
+type PreprocFunctionDef struct{ treesitter.Node }
+
+// AsPreprocFunctionDef converts n to PreprocFunctionDef, reporting whether it is of type preproc_function_def.
+func AsPreprocFunctionDef(n treesitter.Node) (PreprocFunctionDef, bool) {
+	return PreprocFunctionDef{n}, n.IsNamed() && n.Type() == "preproc_function_def"
+}
+
+// Name returns the node in the name field.
+func (n PreprocFunctionDef) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n PreprocFunctionDef) Parameters() PreprocParams {
+	return PreprocParams{n.ChildByFieldName("parameters")}
+}
+
+// Value returns the node in the value field.
+func (n PreprocFunctionDef) Value() PreprocArg {
+	return PreprocArg{n.ChildByFieldName("value")}
+}
+
+// PreprocIf wraps nodes of type preproc_if.
+type PreprocIf struct{ treesitter.Node }
+
+// AsPreprocIf converts n to PreprocIf, reporting whether it is of type preproc_if.
+func AsPreprocIf(n treesitter.Node) (PreprocIf, bool) {
+	return PreprocIf{n}, n.IsNamed() && n.Type() == "preproc_if"
+}
+
+// Alternative returns the node in the alternative field.
+func (n PreprocIf) Alternative() treesitter.Node {
+	return n.ChildByFieldName("alternative")
+}
+
+// Condition returns the node in the condition field.
+func (n PreprocIf) Condition() treesitter.Node {
+	return n.ChildByFieldName("condition")
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n PreprocIf) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// PreprocIfdef wraps nodes of type preproc_ifdef.
+type PreprocIfdef struct{ treesitter.Node }
+
+// AsPreprocIfdef converts n to PreprocIfdef, reporting whether it is of type preproc_ifdef.
+func AsPreprocIfdef(n treesitter.Node) (PreprocIfdef, bool) {
+	return PreprocIfdef{n}, n.IsNamed() && n.Type() == "preproc_ifdef"
+}
+
+// Alternative returns the node in the alternative field.
+func (n PreprocIfdef) Alternative() treesitter.Node {
+	return n.ChildByFieldName("alternative")
+}
+
+// Name returns the node in the name field.
+func (n PreprocIfdef) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n PreprocIfdef) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// PreprocInclude wraps nodes of type preproc_include.
+type PreprocInclude struct{ treesitter.Node }
+
+// AsPreprocInclude converts n to PreprocInclude, reporting whether it is of type preproc_include.
+func AsPreprocInclude(n treesitter.Node) (PreprocInclude, bool) {
+	return PreprocInclude{n}, n.IsNamed() && n.Type() == "preproc_include"
+}
+
+// PathField returns the node in the path field.
+func (n PreprocInclude) PathField() treesitter.Node {
+	return n.ChildByFieldName("path")
+}
+
+// PreprocParams wraps nodes of type preproc_params.
+type PreprocParams struct{ treesitter.Node }
+
+// AsPreprocParams converts n to PreprocParams, reporting whether it is of type preproc_params.
+func AsPreprocParams(n treesitter.Node) (PreprocParams, bool) {
+	return PreprocParams{n}, n.IsNamed() && n.Type() == "preproc_params"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n PreprocParams) Contents() []Identifier {
+	var nodes []Identifier
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, Identifier{c})
+		}
+	}
+	return nodes
+}
+
+// ReturnStatement wraps nodes of type return_statement.
+type ReturnStatement struct{ treesitter.Node }
+
+// AsReturnStatement converts n to ReturnStatement, reporting whether it is of type return_statement.
+func AsReturnStatement(n treesitter.Node) (ReturnStatement, bool) {
+	return ReturnStatement{n}, n.IsNamed() && n.Type() == "return_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ReturnStatement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// SehExceptClause wraps nodes of type seh_except_clause.
+type SehExceptClause struct{ treesitter.Node }
+
+// AsSehExceptClause converts n to SehExceptClause, reporting whether it is of type seh_except_clause.
+func AsSehExceptClause(n treesitter.Node) (SehExceptClause, bool) {
+	return SehExceptClause{n}, n.IsNamed() && n.Type() == "seh_except_clause"
+}
+
+// Body returns the node in the body field.
+func (n SehExceptClause) Body() CompoundStatement {
+	return CompoundStatement{n.ChildByFieldName("body")}
+}
+
+// Filter returns the node in the filter field.
+func (n SehExceptClause) Filter() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("filter")}
+}
+
+// SehFinallyClause wraps nodes of type seh_finally_clause.
+type SehFinallyClause struct{ treesitter.Node }
+
+// AsSehFinallyClause converts n to SehFinallyClause, reporting whether it is of type seh_finally_clause.
+func AsSehFinallyClause(n treesitter.Node) (SehFinallyClause, bool) {
+	return SehFinallyClause{n}, n.IsNamed() && n.Type() == "seh_finally_clause"
+}
+
+// Body returns the node in the body field.
+func (n SehFinallyClause) Body() CompoundStatement {
+	return CompoundStatement{n.ChildByFieldName("body")}
+}
+
+// SehLeaveStatement wraps nodes of type seh_leave_statement.
+type SehLeaveStatement struct{ treesitter.Node }
+
+// AsSehLeaveStatement converts n to SehLeaveStatement, reporting whether it is of type seh_leave_statement.
+func AsSehLeaveStatement(n treesitter.Node) (SehLeaveStatement, bool) {
+	return SehLeaveStatement{n}, n.IsNamed() && n.Type() == "seh_leave_statement"
+}
+
+// SehTryStatement wraps nodes of type seh_try_statement.
+type SehTryStatement struct{ treesitter.Node }
+
+// AsSehTryStatement converts n to SehTryStatement, reporting whether it is of type seh_try_statement.
+func AsSehTryStatement(n treesitter.Node) (SehTryStatement, bool) {
+	return SehTryStatement{n}, n.IsNamed() && n.Type() == "seh_try_statement"
+}
+
+// Body returns the node in the body field.
+func (n SehTryStatement) Body() CompoundStatement {
+	return CompoundStatement{n.ChildByFieldName("body")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n SehTryStatement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// SizedTypeSpecifier wraps nodes of type sized_type_specifier.
+type SizedTypeSpecifier struct{ treesitter.Node }
+
+// AsSizedTypeSpecifier converts n to SizedTypeSpecifier, reporting whether it is of type sized_type_specifier.
+func AsSizedTypeSpecifier(n treesitter.Node) (SizedTypeSpecifier, bool) {
+	return SizedTypeSpecifier{n}, n.IsNamed() && n.Type() == "sized_type_specifier"
+}
+
+// TypeField returns the node in the type field.
+func (n SizedTypeSpecifier) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// SizeofExpression wraps nodes of type sizeof_expression.
+type SizeofExpression struct{ treesitter.Node }
+
+// AsSizeofExpression converts n to SizeofExpression, reporting whether it is of type sizeof_expression.
+func AsSizeofExpression(n treesitter.Node) (SizeofExpression, bool) {
+	return SizeofExpression{n}, n.IsNamed() && n.Type() == "sizeof_expression"
+}
+
+// TypeField returns the node in the type field.
+func (n SizeofExpression) TypeField() TypeDescriptor {
+	return TypeDescriptor{n.ChildByFieldName("type")}
+}
+
+// Value returns the node in the value field.
+func (n SizeofExpression) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// StorageClassSpecifier wraps nodes of type storage_class_specifier.
+type StorageClassSpecifier struct{ treesitter.Node }
+
+// AsStorageClassSpecifier converts n to StorageClassSpecifier, reporting whether it is of type storage_class_specifier.
+func AsStorageClassSpecifier(n treesitter.Node) (StorageClassSpecifier, bool) {
+	return StorageClassSpecifier{n}, n.IsNamed() && n.Type() == "storage_class_specifier"
+}
+
+// StringLiteral wraps nodes of type string_literal.
+type StringLiteral struct{ treesitter.Node }
+
+// AsStringLiteral converts n to StringLiteral, reporting whether it is of type string_literal.
+func AsStringLiteral(n treesitter.Node) (StringLiteral, bool) {
+	return StringLiteral{n}, n.IsNamed() && n.Type() == "string_literal"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n StringLiteral) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// StructSpecifier wraps nodes of type struct_specifier.
+type StructSpecifier struct{ treesitter.Node }
+
+// AsStructSpecifier converts n to StructSpecifier, reporting whether it is of type struct_specifier.
+func AsStructSpecifier(n treesitter.Node) (StructSpecifier, bool) {
+	return StructSpecifier{n}, n.IsNamed() && n.Type() == "struct_specifier"
+}
+
+// Body returns the node in the body field.
+func (n StructSpecifier) Body() FieldDeclarationList {
+	return FieldDeclarationList{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n StructSpecifier) Name() TypeIdentifier {
+	return TypeIdentifier{n.ChildByFieldName("name")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n StructSpecifier) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// SubscriptDesignator wraps nodes of type subscript_designator.
+type SubscriptDesignator struct{ treesitter.Node }
+
+// AsSubscriptDesignator converts n to SubscriptDesignator, reporting whether it is of type subscript_designator.
+func AsSubscriptDesignator(n treesitter.Node) (SubscriptDesignator, bool) {
+	return SubscriptDesignator{n}, n.IsNamed() && n.Type() == "subscript_designator"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n SubscriptDesignator) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// SubscriptExpression wraps nodes of type subscript_expression.
+type SubscriptExpression struct{ treesitter.Node }
+
+// AsSubscriptExpression converts n to SubscriptExpression, reporting whether it is of type subscript_expression.
+func AsSubscriptExpression(n treesitter.Node) (SubscriptExpression, bool) {
+	return SubscriptExpression{n}, n.IsNamed() && n.Type() == "subscript_expression"
+}
+
+// Argument returns the node in the argument field.
+func (n SubscriptExpression) Argument() Expression {
+	return Expression{n.ChildByFieldName("argument")}
+}
+
+// Index returns the node in the index field.
+func (n SubscriptExpression) Index() Expression {
+	return Expression{n.ChildByFieldName("index")}
+}
+
+// SubscriptRangeDesignator wraps nodes of type subscript_range_designator.
+type SubscriptRangeDesignator struct{ treesitter.Node }
+
+// AsSubscriptRangeDesignator converts n to SubscriptRangeDesignator, reporting whether it is of type subscript_range_designator.
+func AsSubscriptRangeDesignator(n treesitter.Node) (SubscriptRangeDesignator, bool) {
+	return SubscriptRangeDesignator{n}, n.IsNamed() && n.Type() == "subscript_range_designator"
+}
+
+// End returns the node in the end field.
+func (n SubscriptRangeDesignator) End() Expression {
+	return Expression{n.ChildByFieldName("end")}
+}
+
+// Start returns the node in the start field.
+func (n SubscriptRangeDesignator) Start() Expression {
+	return Expression{n.ChildByFieldName("start")}
+}
+
+// SwitchStatement wraps nodes of type switch_statement.
+type SwitchStatement struct{ treesitter.Node }
+
+// AsSwitchStatement converts n to SwitchStatement, reporting whether it is of type switch_statement.
+func AsSwitchStatement(n treesitter.Node) (SwitchStatement, bool) {
+	return SwitchStatement{n}, n.IsNamed() && n.Type() == "switch_statement"
+}
+
+// Body returns the node in the body field.
+func (n SwitchStatement) Body() CompoundStatement {
+	return CompoundStatement{n.ChildByFieldName("body")}
+}
+
+// Condition returns the node in the condition field.
+func (n SwitchStatement) Condition() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("condition")}
+}
+
+// TranslationUnit wraps nodes of type translation_unit.
+type TranslationUnit struct{ treesitter.Node }
+
+// AsTranslationUnit converts n to TranslationUnit, reporting whether it is of type translation_unit.
+func AsTranslationUnit(n treesitter.Node) (TranslationUnit, bool) {
+	return TranslationUnit{n}, n.IsNamed() && n.Type() == "translation_unit"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TranslationUnit) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeDefinition wraps nodes of type type_definition.
+type TypeDefinition struct{ treesitter.Node }
+
+// AsTypeDefinition converts n to TypeDefinition, reporting whether it is of type type_definition.
+func AsTypeDefinition(n treesitter.Node) (TypeDefinition, bool) {
+	return TypeDefinition{n}, n.IsNamed() && n.Type() == "type_definition"
+}
+
+// Declarator returns the nodes in the declarator field.
+func (n TypeDefinition) Declarator() []TypeDeclarator {
+	var nodes []TypeDeclarator
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "declarator" {
+			nodes = append(nodes, TypeDeclarator{c})
+		}
+	}
+	return nodes
+}
+
+// TypeField returns the node in the type field.
+func (n TypeDefinition) TypeField() TypeSpecifier {
+	return TypeSpecifier{n.ChildByFieldName("type")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeDefinition) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeDescriptor wraps nodes of type type_descriptor.
+type TypeDescriptor struct{ treesitter.Node }
+
+// AsTypeDescriptor converts n to TypeDescriptor, reporting whether it is of type type_descriptor.
+func AsTypeDescriptor(n treesitter.Node) (TypeDescriptor, bool) {
+	return TypeDescriptor{n}, n.IsNamed() && n.Type() == "type_descriptor"
+}
+
+// Declarator returns the node in the declarator field.
+func (n TypeDescriptor) Declarator() AbstractDeclarator {
+	return AbstractDeclarator{n.ChildByFieldName("declarator")}
+}
+
+// TypeField returns the node in the type field.
+func (n TypeDescriptor) TypeField() TypeSpecifier {
+	return TypeSpecifier{n.ChildByFieldName("type")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeDescriptor) Contents() []TypeQualifier {
+	var nodes []TypeQualifier
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, TypeQualifier{c})
+		}
+	}
+	return nodes
+}
+
+// TypeQualifier wraps nodes of type type_qualifier.
+type TypeQualifier struct{ treesitter.Node }
+
+// AsTypeQualifier converts n to TypeQualifier, reporting whether it is of type type_qualifier.
+func AsTypeQualifier(n treesitter.Node) (TypeQualifier, bool) {
+	return TypeQualifier{n}, n.IsNamed() && n.Type() == "type_qualifier"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n TypeQualifier) Content() AlignasQualifier {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return AlignasQualifier{c}
+		}
+	}
+	return AlignasQualifier{}
+}
+
+// UnaryExpression wraps nodes of type unary_expression.
+type UnaryExpression struct{ treesitter.Node }
+
+// AsUnaryExpression converts n to UnaryExpression, reporting whether it is of type unary_expression.
+func AsUnaryExpression(n treesitter.Node) (UnaryExpression, bool) {
+	return UnaryExpression{n}, n.IsNamed() && n.Type() == "unary_expression"
+}
+
+// Argument returns the node in the argument field.
+func (n UnaryExpression) Argument() treesitter.Node {
+	return n.ChildByFieldName("argument")
+}
+
+// Operator returns the node in the operator field.
+func (n UnaryExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// UnionSpecifier wraps nodes of type union_specifier.
+type UnionSpecifier struct{ treesitter.Node }
+
+// AsUnionSpecifier converts n to UnionSpecifier, reporting whether it is of type union_specifier.
+func AsUnionSpecifier(n treesitter.Node) (UnionSpecifier, bool) {
+	return UnionSpecifier{n}, n.IsNamed() && n.Type() == "union_specifier"
+}
+
+// Body returns the node in the body field.
+func (n UnionSpecifier) Body() FieldDeclarationList {
+	return FieldDeclarationList{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n UnionSpecifier) Name() TypeIdentifier {
+	return TypeIdentifier{n.ChildByFieldName("name")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n UnionSpecifier) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// UpdateExpression wraps nodes of type update_expression.
+type UpdateExpression struct{ treesitter.Node }
+
+// AsUpdateExpression converts n to UpdateExpression, reporting whether it is of type update_expression.
+func AsUpdateExpression(n treesitter.Node) (UpdateExpression, bool) {
+	return UpdateExpression{n}, n.IsNamed() && n.Type() == "update_expression"
+}
+
+// Argument returns the node in the argument field.
+func (n UpdateExpression) Argument() Expression {
+	return Expression{n.ChildByFieldName("argument")}
+}
+
+// Operator returns the node in the operator field.
+func (n UpdateExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// VariadicParameter wraps nodes of type variadic_parameter.
+type VariadicParameter struct{ treesitter.Node }
+
+// AsVariadicParameter converts n to VariadicParameter, reporting whether it is of type variadic_parameter.
+func AsVariadicParameter(n treesitter.Node) (VariadicParameter, bool) {
+	return VariadicParameter{n}, n.IsNamed() && n.Type() == "variadic_parameter"
+}
+
+// WhileStatement wraps nodes of type while_statement.
+type WhileStatement struct{ treesitter.Node }
+
+// AsWhileStatement converts n to WhileStatement, reporting whether it is of type while_statement.
+func AsWhileStatement(n treesitter.Node) (WhileStatement, bool) {
+	return WhileStatement{n}, n.IsNamed() && n.Type() == "while_statement"
+}
+
+// Body returns the node in the body field.
+func (n WhileStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Condition returns the node in the condition field.
+func (n WhileStatement) Condition() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("condition")}
+}
+
+// Character wraps nodes of type character.
+type Character struct{ treesitter.Node }
+
+// AsCharacter converts n to Character, reporting whether it is of type character.
+func AsCharacter(n treesitter.Node) (Character, bool) {
+	return Character{n}, n.IsNamed() && n.Type() == "character"
+}
+
+// Comment wraps nodes of type comment.
+type Comment struct{ treesitter.Node }
+
+// AsComment converts n to Comment, reporting whether it is of type comment.
+func AsComment(n treesitter.Node) (Comment, bool) {
+	return Comment{n}, n.IsNamed() && n.Type() == "comment"
+}
+
+// EscapeSequence wraps nodes of type escape_sequence.
+type EscapeSequence struct{ treesitter.Node }
+
+// AsEscapeSequence converts n to EscapeSequence, reporting whether it is of type escape_sequence.
+func AsEscapeSequence(n treesitter.Node) (EscapeSequence, bool) {
+	return EscapeSequence{n}, n.IsNamed() && n.Type() == "escape_sequence"
+}
+
+// False wraps nodes of type false.
+type False struct{ treesitter.Node }
+
+// AsFalse converts n to False, reporting whether it is of type false.
+func AsFalse(n treesitter.Node) (False, bool) {
+	return False{n}, n.IsNamed() && n.Type() == "false"
+}
+
+// FieldIdentifier wraps nodes of type field_identifier.
+type FieldIdentifier struct{ treesitter.Node }
+
+// AsFieldIdentifier converts n to FieldIdentifier, reporting whether it is of type field_identifier.
+func AsFieldIdentifier(n treesitter.Node) (FieldIdentifier, bool) {
+	return FieldIdentifier{n}, n.IsNamed() && n.Type() == "field_identifier"
+}
+
+// Identifier wraps nodes of type identifier.
+type Identifier struct{ treesitter.Node }
+
+// AsIdentifier converts n to Identifier, reporting whether it is of type identifier.
+func AsIdentifier(n treesitter.Node) (Identifier, bool) {
+	return Identifier{n}, n.IsNamed() && n.Type() == "identifier"
+}
+
+// MsRestrictModifier wraps nodes of type ms_restrict_modifier.
+type MsRestrictModifier struct{ treesitter.Node }
+
+// AsMsRestrictModifier converts n to MsRestrictModifier, reporting whether it is of type ms_restrict_modifier.
+func AsMsRestrictModifier(n treesitter.Node) (MsRestrictModifier, bool) {
+	return MsRestrictModifier{n}, n.IsNamed() && n.Type() == "ms_restrict_modifier"
+}
+
+// MsSignedPtrModifier wraps nodes of type ms_signed_ptr_modifier.
+type MsSignedPtrModifier struct{ treesitter.Node }
+
+// AsMsSignedPtrModifier converts n to MsSignedPtrModifier, reporting whether it is of type ms_signed_ptr_modifier.
+func AsMsSignedPtrModifier(n treesitter.Node) (MsSignedPtrModifier, bool) {
+	return MsSignedPtrModifier{n}, n.IsNamed() && n.Type() == "ms_signed_ptr_modifier"
+}
+
+// MsUnsignedPtrModifier wraps nodes of type ms_unsigned_ptr_modifier.
+type MsUnsignedPtrModifier struct{ treesitter.Node }
+
+// AsMsUnsignedPtrModifier converts n to MsUnsignedPtrModifier, reporting whether it is of type ms_unsigned_ptr_modifier.
+func AsMsUnsignedPtrModifier(n treesitter.Node) (MsUnsignedPtrModifier, bool) {
+	return MsUnsignedPtrModifier{n}, n.IsNamed() && n.Type() == "ms_unsigned_ptr_modifier"
+}
+
+// NumberLiteral wraps nodes of type number_literal.
+type NumberLiteral struct{ treesitter.Node }
+
+// AsNumberLiteral converts n to NumberLiteral, reporting whether it is of type number_literal.
+func AsNumberLiteral(n treesitter.Node) (NumberLiteral, bool) {
+	return NumberLiteral{n}, n.IsNamed() && n.Type() == "number_literal"
+}
+
+// PreprocArg wraps nodes of type preproc_arg.
+type PreprocArg struct{ treesitter.Node }
+
+// AsPreprocArg converts n to PreprocArg, reporting whether it is of type preproc_arg.
+func AsPreprocArg(n treesitter.Node) (PreprocArg, bool) {
+	return PreprocArg{n}, n.IsNamed() && n.Type() == "preproc_arg"
+}
+
+// PreprocDirective wraps nodes of type preproc_directive.
+type PreprocDirective struct{ treesitter.Node }
+
+// AsPreprocDirective converts n to PreprocDirective, reporting whether it is of type preproc_directive.
+func AsPreprocDirective(n treesitter.Node) (PreprocDirective, bool) {
+	return PreprocDirective{n}, n.IsNamed() && n.Type() == "preproc_directive"
+}
+
+// PrimitiveType wraps nodes of type primitive_type.
+type PrimitiveType struct{ treesitter.Node }
+
+// AsPrimitiveType converts n to PrimitiveType, reporting whether it is of type primitive_type.
+func AsPrimitiveType(n treesitter.Node) (PrimitiveType, bool) {
+	return PrimitiveType{n}, n.IsNamed() && n.Type() == "primitive_type"
+}
+
+// StatementIdentifier wraps nodes of type statement_identifier.
+type StatementIdentifier struct{ treesitter.Node }
+
+// AsStatementIdentifier converts n to StatementIdentifier, reporting whether it is of type statement_identifier.
+func AsStatementIdentifier(n treesitter.Node) (StatementIdentifier, bool) {
+	return StatementIdentifier{n}, n.IsNamed() && n.Type() == "statement_identifier"
+}
+
+// StringContent wraps nodes of type string_content.
+type StringContent struct{ treesitter.Node }
+
+// AsStringContent converts n to StringContent, reporting whether it is of type string_content.
+func AsStringContent(n treesitter.Node) (StringContent, bool) {
+	return StringContent{n}, n.IsNamed() && n.Type() == "string_content"
+}
+
+// SystemLibString wraps nodes of type system_lib_string.
+type SystemLibString struct{ treesitter.Node }
+
+// AsSystemLibString converts n to SystemLibString, reporting whether it is of type system_lib_string.
+func AsSystemLibString(n treesitter.Node) (SystemLibString, bool) {
+	return SystemLibString{n}, n.IsNamed() && n.Type() == "system_lib_string"
+}
+
+// True wraps nodes of type true.
+type True struct{ treesitter.Node }
+
+// AsTrue converts n to True, reporting whether it is of type true.
+func AsTrue(n treesitter.Node) (True, bool) {
+	return True{n}, n.IsNamed() && n.Type() == "true"
+}
+
+// TypeIdentifier wraps nodes of type type_identifier.
+type TypeIdentifier struct{ treesitter.Node }
+
+// AsTypeIdentifier converts n to TypeIdentifier, reporting whether it is of type type_identifier.
+func AsTypeIdentifier(n treesitter.Node) (TypeIdentifier, bool) {
+	return TypeIdentifier{n}, n.IsNamed() && n.Type() == "type_identifier"
+}
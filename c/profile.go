@@ -0,0 +1,35 @@
+package c
+
+import "github.com/boldsoftware/treesitter"
+
+func init() {
+	treesitter.RegisterProfile("c", &treesitter.Profile{
+		Declarations: []string{
+			"function_definition",
+			"declaration",
+			"type_definition",
+			"struct_specifier",
+			"union_specifier",
+			"enum_specifier",
+			"preproc_def",
+			"preproc_function_def",
+		},
+		Containers: []string{
+			"preproc_if",
+			"preproc_ifdef",
+			"field_declaration_list",
+		},
+		Statements: []string{
+			"expression_statement",
+			"return_statement",
+			"if_statement",
+			"for_statement",
+			"while_statement",
+			"do_statement",
+			"switch_statement",
+			"goto_statement",
+			"break_statement",
+			"continue_statement",
+		},
+	})
+}
@@ -0,0 +1,176 @@
+// Code generated by tsgen. DO NOT EDIT.
+
+package c
+
+import "github.com/boldsoftware/treesitter"
+
+// Symbols of the named node types, as returned by treesitter.Node.Symbol.
+const (
+	SymIdentifier                      treesitter.Symbol = 1   // identifier
+	SymPreprocArg                      treesitter.Symbol = 18  // preproc_arg
+	SymPreprocDirective                treesitter.Symbol = 19  // preproc_directive
+	SymMsRestrictModifier              treesitter.Symbol = 58  // ms_restrict_modifier
+	SymMsUnsignedPtrModifier           treesitter.Symbol = 59  // ms_unsigned_ptr_modifier
+	SymMsSignedPtrModifier             treesitter.Symbol = 60  // ms_signed_ptr_modifier
+	SymPrimitiveType                   treesitter.Symbol = 91  // primitive_type
+	SymNumberLiteral                   treesitter.Symbol = 137 // number_literal
+	SymCharacter                       treesitter.Symbol = 143 // character
+	SymStringContent                   treesitter.Symbol = 149 // string_content
+	SymEscapeSequence                  treesitter.Symbol = 150 // escape_sequence
+	SymSystemLibString                 treesitter.Symbol = 151 // system_lib_string
+	SymTrue                            treesitter.Symbol = 152 // true
+	SymFalse                           treesitter.Symbol = 153 // false
+	SymComment                         treesitter.Symbol = 156 // comment
+	SymTranslationUnit                 treesitter.Symbol = 157 // translation_unit
+	SymPreprocInclude                  treesitter.Symbol = 160 // preproc_include
+	SymPreprocDef                      treesitter.Symbol = 161 // preproc_def
+	SymPreprocFunctionDef              treesitter.Symbol = 162 // preproc_function_def
+	SymPreprocParams                   treesitter.Symbol = 163 // preproc_params
+	SymPreprocCall                     treesitter.Symbol = 164 // preproc_call
+	SymPreprocIf                       treesitter.Symbol = 165 // preproc_if
+	SymPreprocIfdef                    treesitter.Symbol = 166 // preproc_ifdef
+	SymPreprocElse                     treesitter.Symbol = 167 // preproc_else
+	SymPreprocElif                     treesitter.Symbol = 168 // preproc_elif
+	SymPreprocElifdef                  treesitter.Symbol = 169 // preproc_elifdef
+	SymPreprocDefined                  treesitter.Symbol = 187 // preproc_defined
+	SymFunctionDefinition              treesitter.Symbol = 192 // function_definition
+	SymDeclaration                     treesitter.Symbol = 194 // declaration
+	SymTypeDefinition                  treesitter.Symbol = 195 // type_definition
+	SymLinkageSpecification            treesitter.Symbol = 200 // linkage_specification
+	SymAttributeSpecifier              treesitter.Symbol = 201 // attribute_specifier
+	SymAttribute                       treesitter.Symbol = 202 // attribute
+	SymAttributeDeclaration            treesitter.Symbol = 203 // attribute_declaration
+	SymMsDeclspecModifier              treesitter.Symbol = 204 // ms_declspec_modifier
+	SymMsBasedModifier                 treesitter.Symbol = 205 // ms_based_modifier
+	SymMsCallModifier                  treesitter.Symbol = 206 // ms_call_modifier
+	SymMsUnalignedPtrModifier          treesitter.Symbol = 207 // ms_unaligned_ptr_modifier
+	SymMsPointerModifier               treesitter.Symbol = 208 // ms_pointer_modifier
+	SymDeclarationList                 treesitter.Symbol = 209 // declaration_list
+	SymParenthesizedDeclarator         treesitter.Symbol = 215 // parenthesized_declarator
+	SymAbstractParenthesizedDeclarator treesitter.Symbol = 218 // abstract_parenthesized_declarator
+	SymAttributedDeclarator            treesitter.Symbol = 219 // attributed_declarator
+	SymPointerDeclarator               treesitter.Symbol = 222 // pointer_declarator
+	SymAbstractPointerDeclarator       treesitter.Symbol = 225 // abstract_pointer_declarator
+	SymFunctionDeclarator              treesitter.Symbol = 226 // function_declarator
+	SymAbstractFunctionDeclarator      treesitter.Symbol = 230 // abstract_function_declarator
+	SymArrayDeclarator                 treesitter.Symbol = 232 // array_declarator
+	SymAbstractArrayDeclarator         treesitter.Symbol = 235 // abstract_array_declarator
+	SymInitDeclarator                  treesitter.Symbol = 236 // init_declarator
+	SymCompoundStatement               treesitter.Symbol = 237 // compound_statement
+	SymStorageClassSpecifier           treesitter.Symbol = 238 // storage_class_specifier
+	SymTypeQualifier                   treesitter.Symbol = 239 // type_qualifier
+	SymAlignasQualifier                treesitter.Symbol = 240 // alignas_qualifier
+	SymSizedTypeSpecifier              treesitter.Symbol = 242 // sized_type_specifier
+	SymEnumSpecifier                   treesitter.Symbol = 243 // enum_specifier
+	SymEnumeratorList                  treesitter.Symbol = 244 // enumerator_list
+	SymStructSpecifier                 treesitter.Symbol = 245 // struct_specifier
+	SymUnionSpecifier                  treesitter.Symbol = 246 // union_specifier
+	SymFieldDeclarationList            treesitter.Symbol = 247 // field_declaration_list
+	SymFieldDeclaration                treesitter.Symbol = 249 // field_declaration
+	SymBitfieldClause                  treesitter.Symbol = 251 // bitfield_clause
+	SymEnumerator                      treesitter.Symbol = 252 // enumerator
+	SymVariadicParameter               treesitter.Symbol = 253 // variadic_parameter
+	SymParameterList                   treesitter.Symbol = 254 // parameter_list
+	SymParameterDeclaration            treesitter.Symbol = 256 // parameter_declaration
+	SymAttributedStatement             treesitter.Symbol = 257 // attributed_statement
+	SymLabeledStatement                treesitter.Symbol = 260 // labeled_statement
+	SymExpressionStatement             treesitter.Symbol = 262 // expression_statement
+	SymIfStatement                     treesitter.Symbol = 263 // if_statement
+	SymElseClause                      treesitter.Symbol = 264 // else_clause
+	SymSwitchStatement                 treesitter.Symbol = 265 // switch_statement
+	SymCaseStatement                   treesitter.Symbol = 266 // case_statement
+	SymWhileStatement                  treesitter.Symbol = 267 // while_statement
+	SymDoStatement                     treesitter.Symbol = 268 // do_statement
+	SymForStatement                    treesitter.Symbol = 269 // for_statement
+	SymReturnStatement                 treesitter.Symbol = 271 // return_statement
+	SymBreakStatement                  treesitter.Symbol = 272 // break_statement
+	SymContinueStatement               treesitter.Symbol = 273 // continue_statement
+	SymGotoStatement                   treesitter.Symbol = 274 // goto_statement
+	SymSehTryStatement                 treesitter.Symbol = 275 // seh_try_statement
+	SymSehExceptClause                 treesitter.Symbol = 276 // seh_except_clause
+	SymSehFinallyClause                treesitter.Symbol = 277 // seh_finally_clause
+	SymSehLeaveStatement               treesitter.Symbol = 278 // seh_leave_statement
+	SymCommaExpression                 treesitter.Symbol = 281 // comma_expression
+	SymConditionalExpression           treesitter.Symbol = 282 // conditional_expression
+	SymAssignmentExpression            treesitter.Symbol = 283 // assignment_expression
+	SymPointerExpression               treesitter.Symbol = 284 // pointer_expression
+	SymUnaryExpression                 treesitter.Symbol = 285 // unary_expression
+	SymBinaryExpression                treesitter.Symbol = 286 // binary_expression
+	SymUpdateExpression                treesitter.Symbol = 287 // update_expression
+	SymCastExpression                  treesitter.Symbol = 288 // cast_expression
+	SymTypeDescriptor                  treesitter.Symbol = 289 // type_descriptor
+	SymSizeofExpression                treesitter.Symbol = 290 // sizeof_expression
+	SymAlignofExpression               treesitter.Symbol = 291 // alignof_expression
+	SymOffsetofExpression              treesitter.Symbol = 292 // offsetof_expression
+	SymGenericExpression               treesitter.Symbol = 293 // generic_expression
+	SymSubscriptExpression             treesitter.Symbol = 294 // subscript_expression
+	SymCallExpression                  treesitter.Symbol = 295 // call_expression
+	SymGnuAsmExpression                treesitter.Symbol = 296 // gnu_asm_expression
+	SymGnuAsmQualifier                 treesitter.Symbol = 297 // gnu_asm_qualifier
+	SymGnuAsmOutputOperandList         treesitter.Symbol = 298 // gnu_asm_output_operand_list
+	SymGnuAsmOutputOperand             treesitter.Symbol = 299 // gnu_asm_output_operand
+	SymGnuAsmInputOperandList          treesitter.Symbol = 300 // gnu_asm_input_operand_list
+	SymGnuAsmInputOperand              treesitter.Symbol = 301 // gnu_asm_input_operand
+	SymGnuAsmClobberList               treesitter.Symbol = 302 // gnu_asm_clobber_list
+	SymGnuAsmGotoList                  treesitter.Symbol = 303 // gnu_asm_goto_list
+	SymArgumentList                    treesitter.Symbol = 304 // argument_list
+	SymFieldExpression                 treesitter.Symbol = 305 // field_expression
+	SymCompoundLiteralExpression       treesitter.Symbol = 306 // compound_literal_expression
+	SymParenthesizedExpression         treesitter.Symbol = 307 // parenthesized_expression
+	SymInitializerList                 treesitter.Symbol = 308 // initializer_list
+	SymInitializerPair                 treesitter.Symbol = 309 // initializer_pair
+	SymSubscriptDesignator             treesitter.Symbol = 310 // subscript_designator
+	SymSubscriptRangeDesignator        treesitter.Symbol = 311 // subscript_range_designator
+	SymFieldDesignator                 treesitter.Symbol = 312 // field_designator
+	SymCharLiteral                     treesitter.Symbol = 313 // char_literal
+	SymConcatenatedString              treesitter.Symbol = 314 // concatenated_string
+	SymStringLiteral                   treesitter.Symbol = 315 // string_literal
+	SymNull                            treesitter.Symbol = 316 // null
+	SymMacroTypeSpecifier              treesitter.Symbol = 318 // macro_type_specifier
+	SymFieldIdentifier                 treesitter.Symbol = 355 // field_identifier
+	SymStatementIdentifier             treesitter.Symbol = 356 // statement_identifier
+	SymTypeIdentifier                  treesitter.Symbol = 357 // type_identifier
+)
+
+// Fields, as returned by treesitter.Node.FieldIDForChild.
+const (
+	FieldAlternative    treesitter.FieldID = 1  // alternative
+	FieldArgument       treesitter.FieldID = 2  // argument
+	FieldArguments      treesitter.FieldID = 3  // arguments
+	FieldAssemblyCode   treesitter.FieldID = 4  // assembly_code
+	FieldBody           treesitter.FieldID = 5  // body
+	FieldClobbers       treesitter.FieldID = 6  // clobbers
+	FieldCondition      treesitter.FieldID = 7  // condition
+	FieldConsequence    treesitter.FieldID = 8  // consequence
+	FieldConstraint     treesitter.FieldID = 9  // constraint
+	FieldDeclarator     treesitter.FieldID = 10 // declarator
+	FieldDesignator     treesitter.FieldID = 11 // designator
+	FieldDirective      treesitter.FieldID = 12 // directive
+	FieldEnd            treesitter.FieldID = 13 // end
+	FieldField          treesitter.FieldID = 14 // field
+	FieldFilter         treesitter.FieldID = 15 // filter
+	FieldFunction       treesitter.FieldID = 16 // function
+	FieldGotoLabels     treesitter.FieldID = 17 // goto_labels
+	FieldIndex          treesitter.FieldID = 18 // index
+	FieldInitializer    treesitter.FieldID = 19 // initializer
+	FieldInputOperands  treesitter.FieldID = 20 // input_operands
+	FieldLabel          treesitter.FieldID = 21 // label
+	FieldLeft           treesitter.FieldID = 22 // left
+	FieldMember         treesitter.FieldID = 23 // member
+	FieldName           treesitter.FieldID = 24 // name
+	FieldOperand        treesitter.FieldID = 25 // operand
+	FieldOperator       treesitter.FieldID = 26 // operator
+	FieldOutputOperands treesitter.FieldID = 27 // output_operands
+	FieldParameters     treesitter.FieldID = 28 // parameters
+	FieldPath           treesitter.FieldID = 29 // path
+	FieldPrefix         treesitter.FieldID = 30 // prefix
+	FieldRegister       treesitter.FieldID = 31 // register
+	FieldRight          treesitter.FieldID = 32 // right
+	FieldSize           treesitter.FieldID = 33 // size
+	FieldStart          treesitter.FieldID = 34 // start
+	FieldSymbol         treesitter.FieldID = 35 // symbol
+	FieldType           treesitter.FieldID = 36 // type
+	FieldUnderlyingType treesitter.FieldID = 37 // underlying_type
+	FieldUpdate         treesitter.FieldID = 38 // update
+	FieldValue          treesitter.FieldID = 39 // value
+)
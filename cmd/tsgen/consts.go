@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+
+	"github.com/boldsoftware/treesitter"
+)
+
+// generateConsts returns the formatted source of the symbol and field constants of lang
+// in package pkg.
+func generateConsts(pkg string, lang *treesitter.Language) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by tsgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import \"github.com/boldsoftware/treesitter\"\n")
+
+	// aliases and symbols sharing a name with another are reported as the first one,
+	// so only it gets a constant
+	taken := make(map[string]bool)
+	fmt.Fprintf(&buf, "\n// Symbols of the named node types, as returned by treesitter.Node.Symbol.\nconst (\n")
+	for i := range lang.SymbolCount() {
+		s := treesitter.Symbol(i)
+		if lang.SymbolType(s) != treesitter.SymbolTypeRegular {
+			continue
+		}
+		name := lang.SymbolName(s)
+		if public, _ := lang.SymbolForName(name, true); public != s {
+			continue
+		}
+		ident := unique("Sym"+goName(name), taken)
+		taken[ident] = true
+		fmt.Fprintf(&buf, "%s treesitter.Symbol = %d // %s\n", ident, s, name)
+	}
+	fmt.Fprintf(&buf, ")\n")
+
+	if lang.FieldCount() > 0 {
+		fmt.Fprintf(&buf, "\n// Fields, as returned by treesitter.Node.FieldIDForChild.\nconst (\n")
+		for id := 1; id <= lang.FieldCount(); id++ {
+			name := lang.FieldName(id)
+			ident := unique("Field"+goName(name), taken)
+			taken[ident] = true
+			fmt.Fprintf(&buf, "%s treesitter.FieldID = %d // %s\n", ident, id, name)
+		}
+		fmt.Fprintf(&buf, ")\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boldsoftware/treesitter"
+)
+
+func TestGenerateConsts(t *testing.T) {
+	for _, name := range treesitter.Languages() {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			lang, _ := treesitter.LookupLanguage(name)
+			src, err := generateConsts("lang", lang)
+			assert.NoError(err)
+			f, err := parser.ParseFile(token.NewFileSet(), "symbols.go", src, parser.ParseComments)
+			if !assert.NoError(err) {
+				return
+			}
+
+			var symbols, fields int
+			for _, d := range f.Decls {
+				d, ok := d.(*ast.GenDecl)
+				if !ok || d.Tok != token.CONST {
+					continue
+				}
+				for _, s := range d.Specs {
+					s := s.(*ast.ValueSpec)
+					v, err := strconv.Atoi(s.Values[0].(*ast.BasicLit).Value)
+					assert.NoError(err)
+					typ := strings.TrimSpace(s.Comment.Text())
+					switch s.Type.(*ast.SelectorExpr).Sel.Name {
+					case "Symbol":
+						symbols++
+						sym, ok := lang.SymbolForName(typ, true)
+						assert.True(ok, typ)
+						assert.Equal(sym, treesitter.Symbol(v), typ)
+					case "FieldID":
+						fields++
+						assert.Equal(lang.FieldIDForName(typ), treesitter.FieldID(v), typ)
+					}
+				}
+			}
+			assert.NotZero(symbols)
+			assert.Equal(lang.FieldCount(), fields)
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/boldsoftware/treesitter"
+)
+
+// generator writes the wrappers of the named node types of a grammar.
+type generator struct {
+	buf   bytes.Buffer
+	types map[treesitter.NodeTypeRef]*treesitter.NodeType
+	names map[treesitter.NodeTypeRef]string // Go names of the named node types
+}
+
+// generate returns the formatted source of the wrappers of types in package pkg.
+func generate(pkg string, types []treesitter.NodeType) ([]byte, error) {
+	g := &generator{
+		types: make(map[treesitter.NodeTypeRef]*treesitter.NodeType),
+		names: make(map[treesitter.NodeTypeRef]string),
+	}
+	var named []*treesitter.NodeType
+	for i := range types {
+		t := &types[i]
+		g.types[treesitter.NodeTypeRef{Type: t.Type, Named: t.Named}] = t
+		if t.Named {
+			named = append(named, t)
+		}
+	}
+	if len(named) == 0 {
+		return nil, errors.New("no named node types")
+	}
+
+	// visible types get the plain names: hidden supertypes yield to them, so that
+	// e.g. _expression becomes AnyExpression if the grammar also has an expression node.
+	taken := make(map[string]bool)
+	// the names of the constants generated with -consts, which usually share the package
+	for _, t := range named {
+		taken["Sym"+goName(t.Type)] = true
+		for field := range t.Fields {
+			taken["Field"+goName(field)] = true
+		}
+	}
+	for _, hidden := range []bool{false, true} {
+		for _, t := range named {
+			if strings.HasPrefix(t.Type, "_") != hidden {
+				continue
+			}
+			name := goName(t.Type)
+			if taken[name] && hidden {
+				name = "Any" + name
+			}
+			name = uniqueType(name, taken)
+			taken[name] = true
+			taken["As"+name] = true
+			g.names[treesitter.NodeTypeRef{Type: t.Type, Named: true}] = name
+		}
+	}
+
+	fmt.Fprintf(&g.buf, "// Code generated by tsgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&g.buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&g.buf, "import \"github.com/boldsoftware/treesitter\"\n")
+	for _, t := range named {
+		if t.IsSupertype() {
+			g.supertype(t)
+		} else {
+			g.nodeType(t)
+		}
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) nodeType(t *treesitter.NodeType) {
+	name := g.names[treesitter.NodeTypeRef{Type: t.Type, Named: true}]
+	g.printf("\n// %s wraps nodes of type %s.\n", name, t.Type)
+	g.printf("type %s struct{ treesitter.Node }\n", name)
+	g.printf("\n// As%s converts n to %s, reporting whether it is of type %s.\n", name, name, t.Type)
+	g.printf("func As%s(n treesitter.Node) (%s, bool) {\n", name, name)
+	g.printf("return %s{n}, n.IsNamed() && n.Type() == %q\n}\n", name, t.Type)
+
+	// accessors must not shadow the methods of treesitter.Node
+	taken := make(map[string]bool)
+	node := reflect.TypeFor[treesitter.Node]()
+	for i := range node.NumMethod() {
+		taken[node.Method(i).Name] = true
+	}
+	fields := make([]string, 0, len(t.Fields))
+	for field := range t.Fields {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		method := goName(field)
+		if taken[method] {
+			method += "Field"
+		}
+		method = unique(method, taken)
+		taken[method] = true
+		g.field(name, method, field, t.Fields[field])
+	}
+	if t.Children != nil {
+		method := "Content"
+		if t.Children.Multiple {
+			method = "Contents"
+		}
+		g.field(name, unique(method, taken), "", *t.Children)
+	}
+}
+
+// field writes the accessor of the children of the node type in a field,
+// or of its named children outside of fields if field is "".
+func (g *generator) field(typeName, method, field string, c treesitter.NodeChildren) {
+	elem, wrap := "treesitter.Node", "c"
+	if len(c.Types) == 1 {
+		if name, ok := g.names[c.Types[0]]; ok {
+			elem, wrap = name, name+"{c}"
+		}
+	}
+	cond := fmt.Sprintf("n.FieldNameForChild(i) == %q", field)
+	if field == "" {
+		cond = `c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == ""`
+	}
+
+	switch {
+	case c.Multiple:
+		if field == "" {
+			g.printf("\n// %s returns the named children of the node that aren't in a field.\n", method)
+		} else {
+			g.printf("\n// %s returns the nodes in the %s field.\n", method, field)
+		}
+		g.printf("func (n %s) %s() []%s {\n", typeName, method, elem)
+		g.printf("var nodes []%s\n", elem)
+		g.printf("for i, c := range n.Children() {\nif %s {\nnodes = append(nodes, %s)\n}\n}\n", cond, wrap)
+		g.printf("return nodes\n}\n")
+	case field == "":
+		g.printf("\n// %s returns the named child of the node that isn't in a field.\n", method)
+		g.printf("func (n %s) %s() %s {\n", typeName, method, elem)
+		g.printf("for i, c := range n.Children() {\nif %s {\nreturn %s\n}\n}\n", cond, wrap)
+		g.printf("return %s{}\n}\n", elem)
+	default:
+		g.printf("\n// %s returns the node in the %s field.\n", method, field)
+		g.printf("func (n %s) %s() %s {\n", typeName, method, elem)
+		if elem == "treesitter.Node" {
+			g.printf("return n.ChildByFieldName(%q)\n}\n", field)
+		} else {
+			g.printf("return %s{n.ChildByFieldName(%q)}\n}\n", elem, field)
+		}
+	}
+}
+
+func (g *generator) supertype(t *treesitter.NodeType) {
+	name := g.names[treesitter.NodeTypeRef{Type: t.Type, Named: true}]
+	var named, anonymous []string
+	for _, ref := range g.subtypes(t, nil) {
+		if ref.Named {
+			named = append(named, ref.Type)
+		} else {
+			anonymous = append(anonymous, ref.Type)
+		}
+	}
+	// a type can't appear in two cases of the switch
+	var both []string
+	for _, typ := range named {
+		if slices.Contains(anonymous, typ) {
+			both = append(both, typ)
+		}
+	}
+	named = slices.DeleteFunc(named, func(typ string) bool { return slices.Contains(both, typ) })
+	anonymous = slices.DeleteFunc(anonymous, func(typ string) bool { return slices.Contains(both, typ) })
+
+	g.printf("\n// %s wraps nodes of any of the subtypes of %s.\n", name, t.Type)
+	g.printf("type %s struct{ treesitter.Node }\n", name)
+	g.printf("\n// As%s converts n to %s, reporting whether it is of a subtype of %s.\n", name, name, t.Type)
+	g.printf("func As%s(n treesitter.Node) (%s, bool) {\n", name, name)
+	g.printf("switch n.Type() {\n")
+	for _, c := range []struct {
+		types []string
+		named string
+	}{{named, "n.IsNamed()"}, {anonymous, "!n.IsNamed()"}, {both, "true"}} {
+		if len(c.types) > 0 {
+			g.printf("case %s:\nreturn %s{n}, %s\n", quoteAll(c.types), name, c.named)
+		}
+	}
+	g.printf("}\nreturn %s{n}, false\n}\n", name)
+}
+
+// subtypes returns the node types t stands for, expanding the supertypes among them.
+func (g *generator) subtypes(t *treesitter.NodeType, refs []treesitter.NodeTypeRef) []treesitter.NodeTypeRef {
+	for _, ref := range t.Subtypes {
+		if sub, ok := g.types[ref]; ok && sub.IsSupertype() {
+			refs = g.subtypes(sub, refs)
+		} else if !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// goName returns the exported Go name of a node type or field, e.g.
+// FunctionDeclaration for function_declaration.
+func goName(s string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	name := b.String()
+	if name == "" || !unicode.IsLetter(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// unique returns name, or name followed by the first number making it not taken.
+func unique(name string, taken map[string]bool) string {
+	if !taken[name] {
+		return name
+	}
+	for i := 2; ; i++ {
+		if n := fmt.Sprintf("%s%d", name, i); !taken[n] {
+			return n
+		}
+	}
+}
+
+// uniqueType is like unique for the name of a node type, which must not be taken
+// either once prefixed with As for the name of its conversion function.
+func uniqueType(name string, taken map[string]bool) string {
+	if !taken[name] && !taken["As"+name] {
+		return name
+	}
+	for i := 2; ; i++ {
+		if n := fmt.Sprintf("%s%d", name, i); !taken[n] && !taken["As"+n] {
+			return n
+		}
+	}
+}
+
+func quoteAll(types []string) string {
+	quoted := make([]string, len(types))
+	for i, typ := range types {
+		quoted[i] = fmt.Sprintf("%q", typ)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boldsoftware/treesitter"
+)
+
+const nodeTypes = `[
+  {
+    "type": "_expression",
+    "named": true,
+    "subtypes": [
+      {"type": "_literal", "named": true},
+      {"type": "call", "named": true},
+      {"type": "expression", "named": true}
+    ]
+  },
+  {
+    "type": "_literal",
+    "named": true,
+    "subtypes": [
+      {"type": "number", "named": true},
+      {"type": "true", "named": false}
+    ]
+  },
+  {
+    "type": "block",
+    "named": true,
+    "children": {"multiple": true, "required": false, "types": [{"type": "_expression", "named": true}]}
+  },
+  {
+    "type": "call",
+    "named": true,
+    "fields": {
+      "arguments": {"multiple": true, "required": false, "types": [{"type": "_expression", "named": true}]},
+      "function": {"multiple": false, "required": true, "types": [{"type": "identifier", "named": true}]},
+      "type": {"multiple": false, "required": false, "types": [{"type": "identifier", "named": true}, {"type": "number", "named": true}]}
+    },
+    "children": {"multiple": false, "required": false, "types": [{"type": "block", "named": true}]}
+  },
+  {"type": "expression", "named": true},
+  {"type": "(", "named": false},
+  {"type": "identifier", "named": true},
+  {"type": "number", "named": true},
+  {"type": "true", "named": false},
+  {"type": "field_type", "named": true},
+  {"type": "as_call", "named": true}
+]`
+
+func TestGenerate(t *testing.T) {
+	assert := assert.New(t)
+
+	types, err := treesitter.ParseNodeTypes([]byte(nodeTypes))
+	assert.NoError(err)
+	src, err := generate("lang", types)
+	assert.NoError(err)
+
+	f, err := parser.ParseFile(token.NewFileSet(), "nodes.go", src, parser.ParseComments)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.True(ast.IsGenerated(f))
+	assert.Equal("lang", f.Name.Name)
+
+	decls := make(map[string]string)
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.GenDecl:
+			for _, s := range d.Specs {
+				if s, ok := s.(*ast.TypeSpec); ok {
+					decls[s.Name.Name] = "type"
+				}
+			}
+		case *ast.FuncDecl:
+			name := d.Name.Name
+			if d.Recv != nil {
+				name = d.Recv.List[0].Type.(*ast.Ident).Name + "." + name
+			}
+			decls[name] = string(src[d.Type.Results.Pos()-1 : d.Type.Results.End()-1])
+		}
+	}
+	assert.Equal(map[string]string{
+		// the hidden supertype yields its name to the expression node
+		"AnyExpression":   "type",
+		"AsAnyExpression": "(AnyExpression, bool)",
+		"Literal":         "type",
+		"AsLiteral":       "(Literal, bool)",
+		"Block":           "type",
+		"AsBlock":         "(Block, bool)",
+		"Block.Contents":  "[]AnyExpression",
+		"Call":            "type",
+		"AsCall":          "(Call, bool)",
+		"Call.Arguments":  "[]AnyExpression",
+		"Call.Function":   "Identifier",
+		// Type would shadow treesitter.Node's
+		"Call.TypeField": "treesitter.Node",
+		"Call.Content":   "Block",
+		"Expression":     "type",
+		"AsExpression":   "(Expression, bool)",
+		"Identifier":     "type",
+		"AsIdentifier":   "(Identifier, bool)",
+		"Number":         "type",
+		"AsNumber":       "(Number, bool)",
+		// FieldType is the constant of the type field
+		"FieldType2":   "type",
+		"AsFieldType2": "(FieldType2, bool)",
+		// AsCall converts to Call
+		"AsCall2":   "type",
+		"AsAsCall2": "(AsCall2, bool)",
+	}, decls)
+
+	// nested supertypes are expanded
+	assert.Contains(string(src), `case "number", "call", "expression":`)
+	assert.Contains(string(src), `case "true":`)
+
+	_, err = generate("lang", types[5:6])
+	assert.Error(err)
+}
+
+func TestGoName(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("FunctionDeclaration", goName("function_declaration"))
+	assert.Equal("Expression", goName("_expression"))
+	assert.Equal("X3d", goName("3d"))
+	assert.Equal("X", goName("..."))
+}
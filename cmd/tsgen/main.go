@@ -0,0 +1,116 @@
+// Command tsgen generates typed Go wrappers over treesitter.Node for the node types of a grammar,
+// from the node-types.json file tree-sitter generates along with its parser.
+//
+// For each named node type, such as function_declaration, it emits a type embedding treesitter.Node,
+// a function converting nodes to it, and an accessor for each of its fields:
+//
+//	type FunctionDeclaration struct{ treesitter.Node }
+//
+//	func AsFunctionDeclaration(n treesitter.Node) (FunctionDeclaration, bool)
+//	func (n FunctionDeclaration) Name() Identifier
+//	func (n FunctionDeclaration) Body() Block
+//
+// Supertypes such as _expression get a type too, which fields holding any kind of expression return.
+//
+// With -consts, it instead generates constants for the symbols of the named node types and
+// for the fields of a compiled grammar, so that switches on node kinds compare integers:
+//
+//	const SymFunctionDeclaration treesitter.Symbol = 106 // function_declaration
+//	const FieldName treesitter.FieldID = 20 // name
+//
+// The grammar is one of the bundled languages, or is loaded with -library from a shared library
+// exporting tree_sitter_<language>.
+//
+// It is meant to be run by go generate from the grammar's package:
+//
+//	//go:generate go run github.com/boldsoftware/treesitter/cmd/tsgen -o nodes.go node-types.json
+//	//go:generate go run github.com/boldsoftware/treesitter/cmd/tsgen -consts -language go -o symbols.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/boldsoftware/treesitter"
+	_ "github.com/boldsoftware/treesitter/langs/all"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "tsgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("tsgen", flag.ExitOnError)
+	pkg := fs.String("package", os.Getenv("GOPACKAGE"), "name of the package of the generated file")
+	out := fs.String("o", "", "file to write to, instead of the standard output")
+	consts := fs.Bool("consts", false, "generate symbol and field constants instead of node wrappers")
+	language := fs.String("language", "", "name of the language to generate constants for")
+	library := fs.String("library", "", "shared library to load the language from")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: tsgen [-package name] [-o file] node-types.json")
+		fmt.Fprintln(fs.Output(), "       tsgen -consts -language name [-library path] [-package name] [-o file]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if *pkg == "" {
+		return fmt.Errorf("package name is missing: set -package when not run by go generate")
+	}
+	var src []byte
+	var err error
+	if *consts {
+		src, err = constsSource(*pkg, *language, *library)
+	} else {
+		if fs.NArg() != 1 {
+			fs.Usage()
+			return fmt.Errorf("node-types.json argument is missing")
+		}
+		src, err = nodesSource(*pkg, fs.Arg(0))
+	}
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
+
+func nodesSource(pkg, path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	types, err := treesitter.ParseNodeTypes(data)
+	if err != nil {
+		return nil, err
+	}
+	return generate(pkg, types)
+}
+
+func constsSource(pkg, language, library string) ([]byte, error) {
+	if language == "" {
+		return nil, fmt.Errorf("language argument is missing")
+	}
+	var lang *treesitter.Language
+	if library != "" {
+		var err error
+		if lang, err = treesitter.LoadLanguageFromLibrary(library, "tree_sitter_"+language); err != nil {
+			return nil, err
+		}
+	} else {
+		var ok bool
+		if lang, ok = treesitter.LookupLanguage(language); !ok {
+			return nil, fmt.Errorf("unknown language %q, available: %s",
+				language, strings.Join(treesitter.Languages(), ", "))
+		}
+	}
+	return generateConsts(pkg, lang)
+}
@@ -0,0 +1,125 @@
+package treesitter
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// filenames maps the names of files that have no telling extension to the names of their languages.
+var filenames = map[string][]string{
+	"Dockerfile":     {"dockerfile"},
+	"Containerfile":  {"dockerfile"},
+	"Makefile":       {"make"},
+	"makefile":       {"make"},
+	"GNUmakefile":    {"make"},
+	"CMakeLists.txt": {"cmake"},
+	"go.mod":         {"gomod"},
+	"go.sum":         {"gosum"},
+	"go.work":        {"gowork"},
+	"Gemfile":        {"ruby"},
+	"Rakefile":       {"ruby"},
+	"BUILD":          {"starlark"},
+	"BUILD.bazel":    {"starlark"},
+	"WORKSPACE":      {"starlark"},
+}
+
+// extensions maps file extensions, lowercased with their dot, to the names of the languages
+// files with them may be written in, by order of preference. The few extensions whose case
+// matters, as .C is C++ where .c is C, are also listed as they are written.
+var extensions = map[string][]string{
+	".C":          {"cpp"},
+	".H":          {"cpp"},
+	".bash":       {"bash"},
+	".c":          {"c"},
+	".cc":         {"cpp"},
+	".cjs":        {"javascript"},
+	".cmake":      {"cmake"},
+	".cpp":        {"cpp"},
+	".cs":         {"c_sharp"},
+	".css":        {"css"},
+	".cts":        {"typescript"},
+	".cxx":        {"cpp"},
+	".dockerfile": {"dockerfile"},
+	".ex":         {"elixir"},
+	".exs":        {"elixir"},
+	".go":         {"go"},
+	// headers are shared by C and its descendants: prefer C, their common subset.
+	// RegisterExtension(".h", "cpp") overrides this for C++ code bases.
+	".h":     {"c", "cpp", "objc"},
+	".hcl":   {"hcl"},
+	".hh":    {"cpp"},
+	".hpp":   {"cpp"},
+	".hs":    {"haskell"},
+	".htm":   {"html"},
+	".html":  {"html"},
+	".java":  {"java"},
+	".js":    {"javascript"},
+	".json":  {"json"},
+	".jsx":   {"javascript"},
+	".kt":    {"kotlin"},
+	".kts":   {"kotlin"},
+	".lua":   {"lua"},
+	".m":     {"objc"},
+	".md":    {"markdown"},
+	".mjs":   {"javascript"},
+	".mk":    {"make"},
+	".ml":    {"ocaml"},
+	".mts":   {"typescript"},
+	".php":   {"php"},
+	".proto": {"proto"},
+	".py":    {"python"},
+	".pyi":   {"python"},
+	".rb":    {"ruby"},
+	".rs":    {"rust"},
+	".scala": {"scala"},
+	".sh":    {"bash"},
+	".sql":   {"sql"},
+	".swift": {"swift"},
+	".tf":    {"hcl"},
+	".toml":  {"toml"},
+	// the typescript grammar doesn't support JSX, which has a grammar of its own
+	".ts":   {"typescript"},
+	".tsx":  {"tsx"},
+	".yaml": {"yaml"},
+	".yml":  {"yaml"},
+	".zig":  {"zig"},
+}
+
+// DetectLanguage returns the name of the language of the file with the given name or path,
+// judging from its name or extension, and whether it is one of the registered languages.
+// When several languages share an extension, as C and C++ share .h, the first registered one
+// in order of preference is returned; see RegisterExtension.
+func DetectLanguage(filename string) (string, bool) {
+	base := filepath.Base(filename)
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	candidates, ok := filenames[base]
+	if !ok {
+		ext := filepath.Ext(base)
+		if candidates, ok = extensions[ext]; !ok {
+			candidates = extensions[strings.ToLower(ext)]
+		}
+	}
+	for _, name := range candidates {
+		if languages[resolveAlias(name)] != nil {
+			return resolveAlias(name), true
+		}
+	}
+	return "", false
+}
+
+// RegisterExtension makes DetectLanguage prefer the given languages, in order, for files
+// with extension ext, such as ".h", ahead of the languages it already considers for them.
+// Extensions are not case-sensitive, except those such as ".C" that DetectLanguage tells apart.
+func RegisterExtension(ext string, langNames ...string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := extensions[ext]; !ok {
+		ext = strings.ToLower(ext)
+	}
+	rest := slices.DeleteFunc(slices.Clone(extensions[ext]), func(name string) bool {
+		return slices.Contains(langNames, name)
+	})
+	extensions[ext] = append(slices.Clone(langNames), rest...)
+}
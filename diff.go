@@ -0,0 +1,185 @@
+package treesitter
+
+import (
+	"bytes"
+	"hash/fnv"
+	"slices"
+)
+
+// DiffKind is the kind of difference reported by DiffNodes.
+type DiffKind int
+
+const (
+	DiffInserted DiffKind = iota
+	DiffDeleted
+	DiffChanged
+)
+
+var diffKindNames = []string{
+	"Inserted",
+	"Deleted",
+	"Changed",
+}
+
+func (k DiffKind) String() string {
+	return diffKindNames[k]
+}
+
+// NodeDiff is a subtree that differs between two trees.
+// Old is null for inserted subtrees and New is null for deleted ones.
+type NodeDiff struct {
+	Kind DiffKind
+	Old  Node
+	New  Node
+}
+
+// EqualStructure reports whether a and b have the same shape: the same node types,
+// field names and children, recursively. Positions and the text of tokens are ignored.
+func EqualStructure(a, b Node) bool {
+	if a.Type() != b.Type() || a.IsNamed() != b.IsNamed() || a.ChildCount() != b.ChildCount() {
+		return false
+	}
+	for i := range a.ChildCount() {
+		if a.FieldNameForChild(i) != b.FieldNameForChild(i) || !EqualStructure(a.Child(i), b.Child(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffNodes compares the subtree a, parsed from aSrc, with the subtree b, parsed from bSrc,
+// and returns the smallest subtrees that were inserted, deleted or changed, in source order.
+// Differences in positions alone, such as whitespace changes, are not reported.
+func DiffNodes(a, b Node, aSrc, bSrc []byte) []NodeDiff {
+	d := &differ{
+		aSrc:    aSrc,
+		bSrc:    bSrc,
+		aHashes: map[uintptr]uint64{},
+		bHashes: map[uintptr]uint64{},
+	}
+	d.diff(a, b)
+	return d.diffs
+}
+
+type differ struct {
+	aSrc, bSrc       []byte
+	aHashes, bHashes map[uintptr]uint64
+	diffs            []NodeDiff
+}
+
+func (d *differ) diff(a, b Node) {
+	if d.hash(a, d.aSrc, d.aHashes) == d.hash(b, d.bSrc, d.bHashes) && d.equal(a, b) {
+		return
+	}
+	if a.Type() != b.Type() || a.ChildCount() == 0 || b.ChildCount() == 0 {
+		d.diffs = append(d.diffs, NodeDiff{Kind: DiffChanged, Old: a, New: b})
+		return
+	}
+
+	as := a.ChildNodes()
+	bs := b.ChildNodes()
+	// match identical children with a longest common subsequence,
+	// then compare what is left between consecutive matches
+	lcs := make([][]int, len(as)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bs)+1)
+	}
+	for i := len(as) - 1; i >= 0; i-- {
+		for j := len(bs) - 1; j >= 0; j-- {
+			if d.hash(as[i], d.aSrc, d.aHashes) == d.hash(bs[j], d.bSrc, d.bHashes) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	// nothing in common and a different shape: the whole node was rewritten
+	if lcs[0][0] == 0 && !slices.EqualFunc(as, bs, func(a, b Node) bool { return a.Type() == b.Type() }) {
+		d.diffs = append(d.diffs, NodeDiff{Kind: DiffChanged, Old: a, New: b})
+		return
+	}
+
+	i, j := 0, 0
+	gapA, gapB := 0, 0
+	for i < len(as) || j < len(bs) {
+		switch {
+		case i < len(as) && j < len(bs) && d.hash(as[i], d.aSrc, d.aHashes) == d.hash(bs[j], d.bSrc, d.bHashes):
+			d.diffGap(as[gapA:i], bs[gapB:j])
+			i++
+			j++
+			gapA, gapB = i, j
+		case j < len(bs) && (i == len(as) || lcs[i][j+1] >= lcs[i+1][j]):
+			j++
+		default:
+			i++
+		}
+	}
+	d.diffGap(as[gapA:], bs[gapB:])
+}
+
+// diffGap compares runs of unmatched children, pairing up nodes of the same type in order.
+func (d *differ) diffGap(as, bs []Node) {
+	for len(as) > 0 && len(bs) > 0 {
+		switch {
+		case as[0].Type() == bs[0].Type():
+			d.diff(as[0], bs[0])
+			as, bs = as[1:], bs[1:]
+		case len(as) > len(bs):
+			d.diffs = append(d.diffs, NodeDiff{Kind: DiffDeleted, Old: as[0]})
+			as = as[1:]
+		case len(as) < len(bs):
+			d.diffs = append(d.diffs, NodeDiff{Kind: DiffInserted, New: bs[0]})
+			bs = bs[1:]
+		default:
+			d.diffs = append(d.diffs, NodeDiff{Kind: DiffChanged, Old: as[0], New: bs[0]})
+			as, bs = as[1:], bs[1:]
+		}
+	}
+	for _, n := range as {
+		d.diffs = append(d.diffs, NodeDiff{Kind: DiffDeleted, Old: n})
+	}
+	for _, n := range bs {
+		d.diffs = append(d.diffs, NodeDiff{Kind: DiffInserted, New: n})
+	}
+}
+
+// equal compares two subtrees with matching hashes in full.
+func (d *differ) equal(a, b Node) bool {
+	if a.Type() != b.Type() || a.ChildCount() != b.ChildCount() {
+		return false
+	}
+	if a.ChildCount() == 0 {
+		return bytes.Equal(a.Bytes(d.aSrc), b.Bytes(d.bSrc))
+	}
+	for i := range a.ChildCount() {
+		if !d.equal(a.Child(i), b.Child(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// hash fingerprints the types and token text of a subtree, ignoring positions.
+func (d *differ) hash(n Node, src []byte, cache map[uintptr]uint64) uint64 {
+	if h, ok := cache[n.ID()]; ok {
+		return h
+	}
+	h := fnv.New64a()
+	h.Write([]byte(n.Type()))
+	if n.ChildCount() == 0 {
+		h.Write([]byte{0})
+		h.Write(n.Bytes(src))
+	}
+	var buf [8]byte
+	for _, c := range n.Children() {
+		ch := d.hash(c, src, cache)
+		for k := range buf {
+			buf[k] = byte(ch >> (8 * k))
+		}
+		h.Write(buf[:])
+	}
+	sum := h.Sum64()
+	cache[n.ID()] = sum
+	return sum
+}
@@ -0,0 +1,83 @@
+package treesitter
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// applyDirectives records the effects of the #set!, #offset! and #strip! directives
+// among predicates on m, whose captures come from input.
+func applyDirectives(q *Query, m *QueryMatch, predicates [][]QueryPredicateStep, input []byte) {
+	for _, steps := range predicates {
+		switch q.StringValueForId(steps[0].ValueId) {
+		case "set!":
+			if m.Properties == nil {
+				m.Properties = map[string]string{}
+			}
+			var value string
+			if steps[2].Type == QueryPredicateStepTypeString {
+				value = q.StringValueForId(steps[2].ValueId)
+			}
+			m.Properties[q.StringValueForId(steps[1].ValueId)] = value
+
+		case "offset!":
+			var offsets [4]int
+			for i := range offsets {
+				offsets[i], _ = strconv.Atoi(q.StringValueForId(steps[i+2].ValueId))
+			}
+			for i, c := range m.Captures {
+				if c.Index != steps[1].ValueId {
+					continue
+				}
+				if m.CaptureRanges == nil {
+					m.CaptureRanges = map[int]Range{}
+				}
+				start := offsetPoint(c.Node.StartPoint(), offsets[0], offsets[1])
+				end := offsetPoint(c.Node.EndPoint(), offsets[2], offsets[3])
+				m.CaptureRanges[i] = Range{
+					StartPoint: start,
+					EndPoint:   end,
+					StartByte:  movePointByte(input, c.Node.StartPoint(), c.Node.StartByte(), start),
+					EndByte:    movePointByte(input, c.Node.EndPoint(), c.Node.EndByte(), end),
+				}
+			}
+
+		case "strip!":
+			regex := q.regexes[steps[2].ValueId]
+			for i, c := range m.Captures {
+				if c.Index != steps[1].ValueId {
+					continue
+				}
+				if m.CaptureTexts == nil {
+					m.CaptureTexts = map[int]string{}
+				}
+				m.CaptureTexts[i] = string(regex.ReplaceAll(nodeContent(c.Node, input), nil))
+			}
+		}
+	}
+}
+
+func offsetPoint(p Point, rows, columns int) Point {
+	return Point{Row: max(p.Row+rows, 0), Column: max(p.Column+columns, 0)}
+}
+
+// movePointByte returns the byte offset of p in input, clamped to the end of p's row,
+// given the byte offset of another point, from. Only the rows between them are scanned.
+func movePointByte(input []byte, from Point, fromByte int, p Point) int {
+	off := min(max(fromByte-from.Column, 0), len(input))
+	for row := from.Row; row > p.Row && off > 0; row-- {
+		off = bytes.LastIndexByte(input[:off-1], '\n') + 1
+	}
+	for row := from.Row; row < p.Row; row++ {
+		i := bytes.IndexByte(input[off:], '\n')
+		if i < 0 {
+			return len(input)
+		}
+		off += i + 1
+	}
+	lineEnd := len(input)
+	if i := bytes.IndexByte(input[off:], '\n'); i >= 0 {
+		lineEnd = off + i
+	}
+	return min(off+p.Column, lineEnd)
+}
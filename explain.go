@@ -0,0 +1,209 @@
+package treesitter
+
+// #include "bindings.h"
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// ExplainError describes the syntax error at n, an ERROR or MISSING node of a tree parsed from src,
+// with the tokens the parser expected there and what it found instead, e.g.
+//
+//	expected ')' or ',', found identifier at 12:8
+//
+// Positions are 1-based, with columns in bytes. If src is nil, the source retained by
+// ParseKeepSource is used. ExplainError returns "" if n is neither an error nor missing.
+func ExplainError(n Node, src []byte) string {
+	if !n.IsError() && !n.IsMissing() {
+		return ""
+	}
+	lang := n.t.p.lang
+	// Node.Parent isn't reliable for zero-width nodes such as missing ones,
+	// so navigate from the root down to n instead.
+	path, indices := ancestry(n.t.RootNode(), n)
+	if path == nil {
+		return ""
+	}
+
+	if n.IsMissing() {
+		return fmt.Sprintf("expected %s, found %s at %s",
+			tokenName(lang, n.Symbol()), describeToken(nextLeaf(path, indices), src), formatPosition(n.StartPoint()))
+	}
+
+	found := firstLeaf(n)
+	if found.IsNull() {
+		found = nextLeaf(path, indices)
+	}
+	pos := n.StartPoint()
+
+	var expected []string
+	if state := stateBefore(lang, path, indices); state != 0 {
+		tokens := validTokens(lang, state)
+		if !found.IsNull() && slices.Contains(tokens, found.GrammarSymbol()) {
+			// the error is further into the node: point at the characters no token matched
+			tokens = nil
+			if u := firstErrorLeaf(n); !u.IsNull() {
+				found = u
+			} else if n.Child(0).ChildCount() > 0 {
+				// or at the token following a complete node the parser couldn't fit in the tree
+				found = nextLeaf(path, indices)
+			}
+			if !found.IsNull() {
+				pos = found.StartPoint()
+			}
+		}
+		for _, s := range tokens {
+			if lang.SymbolType(s) == SymbolTypeAuxiliary {
+				continue
+			}
+			if name := tokenName(lang, s); !slices.Contains(expected, name) {
+				expected = append(expected, name)
+			}
+		}
+	}
+
+	msg := "unexpected " + describeToken(found, src)
+	if len(expected) > 0 {
+		msg = "expected " + joinAlternatives(expected) + ", found " + describeToken(found, src)
+	}
+	return msg + " at " + formatPosition(pos)
+}
+
+// ancestry returns the nodes from root down to n and the index of each in its parent,
+// or nil if n isn't in root's tree.
+func ancestry(root, n Node) ([]Node, []int) {
+	if root.Equal(n) {
+		return []Node{root}, []int{0}
+	}
+	for i := range root.ChildCount() {
+		c := root.Child(i)
+		if c.StartByte() > n.StartByte() || c.EndByte() < n.EndByte() {
+			continue
+		}
+		if path, indices := ancestry(c, n); path != nil {
+			indices[0] = i
+			return append([]Node{root}, path...), append([]int{0}, indices...)
+		}
+	}
+	return nil, nil
+}
+
+// stateBefore returns the parse state the parser was in before the last node of path,
+// or 0 if it can't be determined.
+func stateBefore(lang *Language, path []Node, indices []int) StateID {
+	last := len(path) - 1
+	n := path[last]
+	if n.ChildCount() > 0 && !n.IsError() && !n.HasError() {
+		// the state of a non-terminal is the one it was reduced from
+		return n.ParseState()
+	}
+	if last == 0 {
+		return 1
+	}
+	parent := path[last-1]
+	for i := indices[last] - 1; i >= 0; i-- {
+		prev := parent.Child(i)
+		if prev.IsExtra() {
+			continue
+		}
+		p := append(slices.Clip(path[:last]), prev)
+		ix := append(slices.Clip(indices[:last]), i)
+		if state := stateBefore(lang, p, ix); state != 0 && state != math.MaxUint16 {
+			return lang.NextState(state, prev.GrammarSymbol())
+		}
+		return 0
+	}
+	return stateBefore(lang, path[:last], indices[:last])
+}
+
+// validTokens returns the tokens that can follow in the given parse state.
+func validTokens(lang *Language, state StateID) []Symbol {
+	var buf [64]Symbol
+	n := C.language_valid_tokens((*C.TSLanguage)(lang.ptr), C.TSStateId(state), &buf[0], C.uint32_t(len(buf)))
+	if int(n) <= len(buf) {
+		return slices.Clone(buf[:n])
+	}
+	tokens := make([]Symbol, n)
+	C.language_valid_tokens((*C.TSLanguage)(lang.ptr), C.TSStateId(state), &tokens[0], n)
+	return tokens
+}
+
+func tokenName(lang *Language, s Symbol) string {
+	switch {
+	case s == 0:
+		return "end of file"
+	case lang.SymbolType(s) == SymbolTypeAnonymous:
+		return "'" + lang.SymbolName(s) + "'"
+	}
+	return lang.SymbolName(s)
+}
+
+// describeToken returns the name of the token found where another was expected.
+func describeToken(n Node, src []byte) string {
+	switch {
+	case n.IsNull():
+		return "end of file"
+	case n.IsError():
+		// characters no token matches
+		return "'" + n.Text(src) + "'"
+	case !n.IsNamed():
+		return "'" + n.Type() + "'"
+	}
+	return n.Type()
+}
+
+func joinAlternatives(names []string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+func formatPosition(p Point) string {
+	return fmt.Sprintf("%d:%d", p.Row+1, p.Column+1)
+}
+
+// firstLeaf returns the first token of n that isn't an extra, or a null node if there is none.
+func firstLeaf(n Node) Node {
+	if n.ChildCount() == 0 {
+		if n.IsExtra() {
+			return Node{}
+		}
+		return n
+	}
+	for i := range n.ChildCount() {
+		if leaf := firstLeaf(n.Child(i)); !leaf.IsNull() {
+			return leaf
+		}
+	}
+	return Node{}
+}
+
+// nextLeaf returns the first token after the last node of path that isn't an extra,
+// or a null node if there is none.
+func nextLeaf(path []Node, indices []int) Node {
+	for i := len(path) - 1; i > 0; i-- {
+		parent := path[i-1]
+		for j := indices[i] + 1; j < parent.ChildCount(); j++ {
+			if leaf := firstLeaf(parent.Child(j)); !leaf.IsNull() {
+				return leaf
+			}
+		}
+	}
+	return Node{}
+}
+
+// firstErrorLeaf returns the first run of characters in n that no token matched,
+// or a null node if there is none.
+func firstErrorLeaf(n Node) Node {
+	for c := range n.PreOrder() {
+		if c.IsError() && c.ChildCount() == 0 {
+			return c
+		}
+	}
+	return Node{}
+}
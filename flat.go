@@ -0,0 +1,261 @@
+package treesitter
+
+// #include "bindings.h"
+import "C"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"unsafe"
+)
+
+// FieldID identifies a field name of a Language; 0 means no field.
+type FieldID uint16
+
+// NodeFlags records the boolean properties of a node in a FlatTree.
+type NodeFlags uint8
+
+const (
+	NodeNamed NodeFlags = 1 << iota
+	NodeMissing
+	NodeExtra
+	NodeError
+	NodeHasError
+)
+
+// FlatTree is a read-only snapshot of all the nodes of a Tree held in plain Go slices,
+// so it can be analysed, stored and reloaded without cgo.
+//
+// Nodes are stored in pre-order; node 0 is the root. All the per-node slices
+// have the same length and are indexed by node.
+type FlatTree struct {
+	// SymbolNames and FieldNames are the language's names indexed by Symbol and FieldID.
+	SymbolNames []string
+	FieldNames  []string
+
+	Symbols     []Symbol
+	Flags       []NodeFlags
+	FieldIDs    []FieldID
+	Parents     []int32 // -1 for the root
+	StartBytes  []uint32
+	EndBytes    []uint32
+	StartPoints []Point
+	EndPoints   []Point
+}
+
+// Len returns the number of nodes in the tree.
+func (f *FlatTree) Len() int { return len(f.Symbols) }
+
+// Type returns the type of node i.
+func (f *FlatTree) Type(i int) string {
+	if f.Symbols[i] == math.MaxUint16 {
+		return "ERROR"
+	}
+	return f.SymbolNames[f.Symbols[i]]
+}
+
+// FieldName returns the field name of node i in its parent, or "" if it has none.
+func (f *FlatTree) FieldName(i int) string { return f.FieldNames[f.FieldIDs[i]] }
+
+// Flatten copies all the nodes of the tree into a FlatTree,
+// collecting them in a single traversal on the C side.
+func (t *Tree) Flatten() *FlatTree {
+	lang := t.p.lang
+	f := &FlatTree{
+		SymbolNames: make([]string, lang.SymbolCount()),
+		FieldNames:  make([]string, lang.FieldCount()+1),
+	}
+	for i := range f.SymbolNames {
+		f.SymbolNames[i] = lang.SymbolName(Symbol(i))
+	}
+	for i := 1; i < len(f.FieldNames); i++ {
+		f.FieldNames[i] = lang.FieldName(i)
+	}
+
+	root := t.RootNode()
+	n := int(C.ts_node_descendant_count(root.c))
+	f.Symbols = make([]Symbol, n)
+	f.Flags = make([]NodeFlags, n)
+	f.FieldIDs = make([]FieldID, n)
+	f.Parents = make([]int32, n)
+	f.StartBytes = make([]uint32, n)
+	f.EndBytes = make([]uint32, n)
+	startPoints := make([]C.TSPoint, n)
+	endPoints := make([]C.TSPoint, n)
+	n = int(C.tree_flatten(root.c, C.uint32_t(n),
+		unsafe.SliceData(f.Symbols),
+		(*C.uint8_t)(unsafe.Pointer(unsafe.SliceData(f.Flags))),
+		(*C.TSFieldId)(unsafe.Pointer(unsafe.SliceData(f.FieldIDs))),
+		(*C.int32_t)(unsafe.Pointer(unsafe.SliceData(f.Parents))),
+		(*C.uint32_t)(unsafe.Pointer(unsafe.SliceData(f.StartBytes))),
+		(*C.uint32_t)(unsafe.Pointer(unsafe.SliceData(f.EndBytes))),
+		unsafe.SliceData(startPoints),
+		unsafe.SliceData(endPoints),
+	))
+	runtime.KeepAlive(t)
+
+	f.StartPoints = make([]Point, n)
+	f.EndPoints = make([]Point, n)
+	for i := range n {
+		f.StartPoints[i] = Point{Row: int(startPoints[i].row), Column: int(startPoints[i].column)}
+		f.EndPoints[i] = Point{Row: int(endPoints[i].row), Column: int(endPoints[i].column)}
+	}
+	return f
+}
+
+const flatTreeMagic = "TSFT\x01"
+
+var ErrInvalidTreeData = errors.New("invalid serialized tree")
+
+// Marshal serializes all the nodes of the tree to a compact binary form
+// that can be loaded back, in any process, with UnmarshalTree.
+func (t *Tree) Marshal() []byte {
+	return t.Flatten().Marshal()
+}
+
+// Marshal serializes the tree to the format read by UnmarshalTree.
+func (f *FlatTree) Marshal() []byte {
+	b := []byte(flatTreeMagic)
+	b = appendStrings(b, f.SymbolNames)
+	b = appendStrings(b, f.FieldNames)
+	b = binary.AppendUvarint(b, uint64(f.Len()))
+	for i := range f.Symbols {
+		b = binary.AppendUvarint(b, uint64(f.Symbols[i]))
+		b = append(b, byte(f.Flags[i]))
+		b = binary.AppendUvarint(b, uint64(f.FieldIDs[i]))
+		// parents always precede their children
+		b = binary.AppendUvarint(b, uint64(int32(i)-f.Parents[i]))
+		b = binary.AppendUvarint(b, uint64(f.StartBytes[i]))
+		b = binary.AppendUvarint(b, uint64(f.EndBytes[i]-f.StartBytes[i]))
+		b = binary.AppendUvarint(b, uint64(f.StartPoints[i].Row))
+		b = binary.AppendUvarint(b, uint64(f.StartPoints[i].Column))
+		b = binary.AppendUvarint(b, uint64(f.EndPoints[i].Row-f.StartPoints[i].Row))
+		b = binary.AppendUvarint(b, uint64(f.EndPoints[i].Column))
+	}
+	return b
+}
+
+// UnmarshalTree loads a tree serialized with Tree.Marshal.
+func UnmarshalTree(data []byte) (*FlatTree, error) {
+	if len(data) < len(flatTreeMagic) || string(data[:len(flatTreeMagic)]) != flatTreeMagic {
+		return nil, fmt.Errorf("%w: bad header", ErrInvalidTreeData)
+	}
+	r := &byteReader{b: data[len(flatTreeMagic):]}
+
+	f := &FlatTree{
+		SymbolNames: r.strings(),
+		FieldNames:  r.strings(),
+	}
+	n := r.uvarint()
+	if r.err == nil && n > uint64(len(r.b)) {
+		r.err = fmt.Errorf("%w: node count %d exceeds data", ErrInvalidTreeData, n)
+	}
+	for i := int32(0); r.err == nil && uint64(i) < n; i++ {
+		sym := r.uvarint()
+		flags := r.byte()
+		field := r.uvarint()
+		parentDelta := r.uvarint()
+		start := uint32(r.uvarint())
+		end := start + uint32(r.uvarint())
+		startRow := int(r.uvarint())
+		startCol := int(r.uvarint())
+		endRow := startRow + int(r.uvarint())
+		endCol := int(r.uvarint())
+		if r.err != nil {
+			break
+		}
+		// the root's parent is -1, any other node's one of the nodes before it
+		validParent := parentDelta >= 1 && parentDelta <= uint64(i)
+		if i == 0 {
+			validParent = parentDelta == 1
+		}
+		if (sym >= uint64(len(f.SymbolNames)) && sym != math.MaxUint16) || field >= uint64(len(f.FieldNames)) || !validParent {
+			return nil, fmt.Errorf("%w: node %d is malformed", ErrInvalidTreeData, i)
+		}
+		parent := i - int32(parentDelta)
+		f.Symbols = append(f.Symbols, Symbol(sym))
+		f.Flags = append(f.Flags, NodeFlags(flags))
+		f.FieldIDs = append(f.FieldIDs, FieldID(field))
+		f.Parents = append(f.Parents, parent)
+		f.StartBytes = append(f.StartBytes, start)
+		f.EndBytes = append(f.EndBytes, end)
+		f.StartPoints = append(f.StartPoints, Point{Row: startRow, Column: startCol})
+		f.EndPoints = append(f.EndPoints, Point{Row: endRow, Column: endCol})
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if len(r.b) != 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrInvalidTreeData, len(r.b))
+	}
+	return f, nil
+}
+
+func appendStrings(b []byte, ss []string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(ss)))
+	for _, s := range ss {
+		b = binary.AppendUvarint(b, uint64(len(s)))
+		b = append(b, s...)
+	}
+	return b
+}
+
+// byteReader decodes the serialized tree, remembering the first error.
+type byteReader struct {
+	b   []byte
+	err error
+}
+
+func (r *byteReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		r.err = fmt.Errorf("%w: truncated", ErrInvalidTreeData)
+		return 0
+	}
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *byteReader) byte() byte {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.b) == 0 {
+		r.err = fmt.Errorf("%w: truncated", ErrInvalidTreeData)
+		return 0
+	}
+	v := r.b[0]
+	r.b = r.b[1:]
+	return v
+}
+
+func (r *byteReader) strings() []string {
+	n := r.uvarint()
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.b)) {
+		r.err = fmt.Errorf("%w: string count %d exceeds data", ErrInvalidTreeData, n)
+		return nil
+	}
+	ss := make([]string, n)
+	for i := range ss {
+		l := r.uvarint()
+		if r.err != nil {
+			return nil
+		}
+		if l > uint64(len(r.b)) {
+			r.err = fmt.Errorf("%w: truncated", ErrInvalidTreeData)
+			return nil
+		}
+		ss[i] = string(r.b[:l])
+		r.b = r.b[l:]
+	}
+	return ss
+}
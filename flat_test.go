@@ -0,0 +1,61 @@
+package treesitter
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalTree(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 +\n 22 + a"))
+	assert.NoError(err)
+
+	data := tree.Marshal()
+	f, err := UnmarshalTree(data)
+	assert.NoError(err)
+	assert.Equal(tree.Flatten(), f)
+
+	var nodes []Node
+	it := NewIterator(tree.RootNode(), DFSMode)
+	assert.ErrorContains(it.ForEach(func(n Node) error {
+		nodes = append(nodes, n)
+		return nil
+	}), "EOF")
+
+	assert.Equal(len(nodes), f.Len())
+	assert.Equal(int32(-1), f.Parents[0])
+	for i, n := range nodes {
+		assert.Equal(n.Type(), f.Type(i))
+		assert.Equal(n.StartByte(), int(f.StartBytes[i]))
+		assert.Equal(n.EndByte(), int(f.EndBytes[i]))
+		assert.Equal(n.StartPoint(), f.StartPoints[i])
+		assert.Equal(n.EndPoint(), f.EndPoints[i])
+		assert.Equal(n.IsNamed(), f.Flags[i]&NodeNamed != 0)
+		assert.Equal(n.IsError(), f.Flags[i]&NodeError != 0)
+		if i > 0 {
+			assert.True(nodes[f.Parents[i]].Equal(n.Parent()))
+		}
+	}
+	assert.Equal("left", f.FieldName(2))
+
+	_, err = UnmarshalTree(data[:len(data)-1])
+	assert.ErrorIs(err, ErrInvalidTreeData)
+	_, err = UnmarshalTree(append(data, 0))
+	assert.ErrorIs(err, ErrInvalidTreeData)
+	_, err = UnmarshalTree([]byte("junk"))
+	assert.ErrorIs(err, ErrInvalidTreeData)
+
+	// the root's parent must be -1, any other node's one of the nodes before it
+	for _, tt := range []struct{ node, parent int32 }{{0, -2}, {0, 0}, {1, -1}, {2, 2}, {2, 3}} {
+		malformed := *f
+		malformed.Parents = slices.Clone(f.Parents)
+		malformed.Parents[tt.node] = tt.parent
+		_, err = UnmarshalTree(malformed.Marshal())
+		assert.ErrorIs(err, ErrInvalidTreeData, tt)
+	}
+}
@@ -1,15 +1,30 @@
 package golang
 
+//go:generate go run ../cmd/tsgen -o nodes.go node-types.json
+//go:generate go run ../cmd/tsgen -consts -language go -o symbols.go
+
 //#include "parser.h"
 //TSLanguage *tree_sitter_go();
 import "C"
 import (
+	_ "embed"
 	"unsafe"
 
 	"github.com/boldsoftware/treesitter"
 )
 
+var language = treesitter.NewLanguage(unsafe.Pointer(C.tree_sitter_go()))
+
+//go:embed node-types.json
+var nodeTypes []byte
+
 func init() {
-	ptr := unsafe.Pointer(C.tree_sitter_go())
-	treesitter.RegisterLanguage("go", treesitter.NewLanguage(ptr))
+	if err := language.SetNodeTypes(nodeTypes); err != nil {
+		panic(err)
+	}
+	treesitter.RegisterLanguage("go", language)
+	treesitter.RegisterAlias("golang", "go")
 }
+
+// GetLanguage returns the Go language, which is also registered as "go".
+func GetLanguage() *treesitter.Language { return language }
@@ -5,7 +5,7 @@ import (
 	"testing"
 
 	"github.com/boldsoftware/treesitter"
-	_ "github.com/boldsoftware/treesitter/golang"
+	"github.com/boldsoftware/treesitter/golang"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,6 +20,92 @@ func TestGrammar(t *testing.T) {
 	)
 }
 
+func TestSymbols(t *testing.T) {
+	assert := assert.New(t)
+
+	n, err := treesitter.Parse(context.Background(), []byte("package main\nfunc f() {}"), "go")
+	assert.NoError(err)
+	fn := n.NamedChild(1)
+	assert.Equal(golang.SymFunctionDeclaration, fn.Symbol())
+	assert.Equal(golang.FieldName, fn.FieldIDForChild(1))
+	assert.Equal(golang.SymIdentifier, fn.ChildByFieldID(golang.FieldName).Symbol())
+	assert.Zero(fn.FieldIDForChild(fn.ChildCount()))
+
+	// fields inherited from hidden children are reported too
+	lang := golang.GetLanguage()
+	n, err = treesitter.Parse(context.Background(), []byte("package main\nfunc f(a, b int) (c int) { for i := range x { a[i], b = <-ch, c + 1 } }"), "go")
+	assert.NoError(err)
+	var walk func(n treesitter.Node)
+	walk = func(n treesitter.Node) {
+		for i := range n.ChildCount() {
+			assert.Equal(n.FieldNameForChild(i), lang.FieldName(int(n.FieldIDForChild(i))), n.Type())
+			walk(n.Child(i))
+		}
+	}
+	walk(n)
+}
+
+func TestNodeTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	lang := golang.GetLanguage()
+	assert.NotEmpty(lang.NodeTypes())
+	fn, ok := lang.NodeType("function_declaration", true)
+	if assert.True(ok) {
+		assert.True(fn.Fields["name"].Required)
+		assert.Equal([]treesitter.NodeTypeRef{{Type: "identifier", Named: true}}, fn.Fields["name"].Types)
+	}
+}
+
+func TestNodeWrappers(t *testing.T) {
+	assert := assert.New(t)
+
+	src := []byte("package main\nfunc f(a int) {}")
+	n, err := treesitter.Parse(context.Background(), src, "go")
+	assert.NoError(err)
+	fn, ok := golang.AsFunctionDeclaration(n.NamedChild(1))
+	assert.True(ok)
+	assert.Equal("f", fn.Name().Text(src))
+	assert.Len(fn.Parameters().Contents(), 1)
+	assert.False(fn.Body().IsNull())
+
+	_, ok = golang.AsFunctionDeclaration(n.NamedChild(0))
+	assert.False(ok)
+}
+
+func TestSupertypes(t *testing.T) {
+	assert := assert.New(t)
+
+	lang, _ := treesitter.LookupLanguage("go")
+	var names []string
+	for _, s := range lang.Supertypes() {
+		names = append(names, lang.SymbolName(s))
+	}
+	assert.Subset(names, []string{"_expression", "_simple_type", "_statement"})
+
+	expression, ok := lang.SymbolForName("_expression", true)
+	assert.True(ok)
+	var subtypes []string
+	for _, s := range lang.Subtypes(expression) {
+		subtypes = append(subtypes, lang.SymbolName(s))
+	}
+	assert.Subset(subtypes, []string{"binary_expression", "call_expression", "identifier", "int_literal"})
+	assert.NotContains(subtypes, "_expression")
+
+	n, err := treesitter.Parse(context.Background(), []byte("package main\nvar a = f(b)"), "go")
+	assert.NoError(err)
+	call := n.NamedChild(1).NamedChild(0).ChildByFieldName("value").NamedChild(0)
+	assert.Equal(golang.SymCallExpression, call.Symbol())
+	for _, n := range []treesitter.Node{call, call.ChildByFieldName("function"), call.ChildByFieldName("arguments").NamedChild(0)} {
+		supertypes := n.Supertypes()
+		if assert.Len(supertypes, 1, n.Type()) {
+			assert.Equal("_expression", lang.SymbolName(supertypes[0]))
+		}
+	}
+	assert.Empty(call.ChildByFieldName("arguments").Supertypes())
+	assert.Empty(n.Supertypes())
+}
+
 // TestStringAllocs tests that cstrings map loaded up in NewLanguage
 // means that string methods on nodes to do not allocate.
 func TestStringAllocs(t *testing.T) {
@@ -63,3 +149,15 @@ func main() {
 		t.Errorf("AllocsPerRun=%v, want %v", nodeAllocs, wantNodeAllocs)
 	}
 }
+
+func TestGrammarType(t *testing.T) {
+	assert := assert.New(t)
+
+	n, err := treesitter.Parse(context.Background(), []byte("package main"), "go")
+	assert.NoError(err)
+
+	name := n.NamedChild(0).NamedChild(0)
+	assert.Equal("package_identifier", name.Type())
+	assert.Equal("identifier", name.GrammarType())
+	assert.NotEqual(name.Symbol(), name.GrammarSymbol())
+}
@@ -0,0 +1,1927 @@
+// Code generated by tsgen. DO NOT EDIT.
+
+package golang
+
+import "github.com/boldsoftware/treesitter"
+
+// Expression wraps nodes of any of the subtypes of _expression.
+type Expression struct{ treesitter.Node }
+
+// AsExpression converts n to Expression, reporting whether it is of a subtype of _expression.
+func AsExpression(n treesitter.Node) (Expression, bool) {
+	switch n.Type() {
+	case "binary_expression", "call_expression", "composite_literal", "false", "float_literal", "func_literal", "identifier", "imaginary_literal", "index_expression", "int_literal", "interpreted_string_literal", "iota", "nil", "parenthesized_expression", "raw_string_literal", "rune_literal", "selector_expression", "slice_expression", "true", "type_assertion_expression", "type_conversion_expression", "type_instantiation_expression", "unary_expression":
+		return Expression{n}, n.IsNamed()
+	}
+	return Expression{n}, false
+}
+
+// SimpleStatement wraps nodes of any of the subtypes of _simple_statement.
+type SimpleStatement struct{ treesitter.Node }
+
+// AsSimpleStatement converts n to SimpleStatement, reporting whether it is of a subtype of _simple_statement.
+func AsSimpleStatement(n treesitter.Node) (SimpleStatement, bool) {
+	switch n.Type() {
+	case "assignment_statement", "dec_statement", "expression_statement", "inc_statement", "send_statement", "short_var_declaration":
+		return SimpleStatement{n}, n.IsNamed()
+	}
+	return SimpleStatement{n}, false
+}
+
+// SimpleType wraps nodes of any of the subtypes of _simple_type.
+type SimpleType struct{ treesitter.Node }
+
+// AsSimpleType converts n to SimpleType, reporting whether it is of a subtype of _simple_type.
+func AsSimpleType(n treesitter.Node) (SimpleType, bool) {
+	switch n.Type() {
+	case "array_type", "channel_type", "function_type", "generic_type", "interface_type", "map_type", "negated_type", "pointer_type", "qualified_type", "slice_type", "struct_type", "type_identifier":
+		return SimpleType{n}, n.IsNamed()
+	}
+	return SimpleType{n}, false
+}
+
+// Statement wraps nodes of any of the subtypes of _statement.
+type Statement struct{ treesitter.Node }
+
+// AsStatement converts n to Statement, reporting whether it is of a subtype of _statement.
+func AsStatement(n treesitter.Node) (Statement, bool) {
+	switch n.Type() {
+	case "assignment_statement", "dec_statement", "expression_statement", "inc_statement", "send_statement", "short_var_declaration", "block", "break_statement", "const_declaration", "continue_statement", "defer_statement", "empty_statement", "expression_switch_statement", "fallthrough_statement", "for_statement", "go_statement", "goto_statement", "if_statement", "labeled_statement", "return_statement", "select_statement", "type_declaration", "type_switch_statement", "var_declaration":
+		return Statement{n}, n.IsNamed()
+	}
+	return Statement{n}, false
+}
+
+// ArgumentList wraps nodes of type argument_list.
+type ArgumentList struct{ treesitter.Node }
+
+// AsArgumentList converts n to ArgumentList, reporting whether it is of type argument_list.
+func AsArgumentList(n treesitter.Node) (ArgumentList, bool) {
+	return ArgumentList{n}, n.IsNamed() && n.Type() == "argument_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ArgumentList) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ArrayType wraps nodes of type array_type.
+type ArrayType struct{ treesitter.Node }
+
+// AsArrayType converts n to ArrayType, reporting whether it is of type array_type.
+func AsArrayType(n treesitter.Node) (ArrayType, bool) {
+	return ArrayType{n}, n.IsNamed() && n.Type() == "array_type"
+}
+
+// Element returns the node in the element field.
+func (n ArrayType) Element() treesitter.Node {
+	return n.ChildByFieldName("element")
+}
+
+// Length returns the node in the length field.
+func (n ArrayType) Length() Expression {
+	return Expression{n.ChildByFieldName("length")}
+}
+
+// AssignmentStatement wraps nodes of type assignment_statement.
+type AssignmentStatement struct{ treesitter.Node }
+
+// AsAssignmentStatement converts n to AssignmentStatement, reporting whether it is of type assignment_statement.
+func AsAssignmentStatement(n treesitter.Node) (AssignmentStatement, bool) {
+	return AssignmentStatement{n}, n.IsNamed() && n.Type() == "assignment_statement"
+}
+
+// Left returns the node in the left field.
+func (n AssignmentStatement) Left() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("left")}
+}
+
+// Operator returns the node in the operator field.
+func (n AssignmentStatement) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// Right returns the node in the right field.
+func (n AssignmentStatement) Right() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("right")}
+}
+
+// BinaryExpression wraps nodes of type binary_expression.
+type BinaryExpression struct{ treesitter.Node }
+
+// AsBinaryExpression converts n to BinaryExpression, reporting whether it is of type binary_expression.
+func AsBinaryExpression(n treesitter.Node) (BinaryExpression, bool) {
+	return BinaryExpression{n}, n.IsNamed() && n.Type() == "binary_expression"
+}
+
+// Left returns the node in the left field.
+func (n BinaryExpression) Left() Expression {
+	return Expression{n.ChildByFieldName("left")}
+}
+
+// Operator returns the node in the operator field.
+func (n BinaryExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// Right returns the node in the right field.
+func (n BinaryExpression) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// Block wraps nodes of type block.
+type Block struct{ treesitter.Node }
+
+// AsBlock converts n to Block, reporting whether it is of type block.
+func AsBlock(n treesitter.Node) (Block, bool) {
+	return Block{n}, n.IsNamed() && n.Type() == "block"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n Block) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// BreakStatement wraps nodes of type break_statement.
+type BreakStatement struct{ treesitter.Node }
+
+// AsBreakStatement converts n to BreakStatement, reporting whether it is of type break_statement.
+func AsBreakStatement(n treesitter.Node) (BreakStatement, bool) {
+	return BreakStatement{n}, n.IsNamed() && n.Type() == "break_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n BreakStatement) Content() LabelName {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return LabelName{c}
+		}
+	}
+	return LabelName{}
+}
+
+// CallExpression wraps nodes of type call_expression.
+type CallExpression struct{ treesitter.Node }
+
+// AsCallExpression converts n to CallExpression, reporting whether it is of type call_expression.
+func AsCallExpression(n treesitter.Node) (CallExpression, bool) {
+	return CallExpression{n}, n.IsNamed() && n.Type() == "call_expression"
+}
+
+// Arguments returns the node in the arguments field.
+func (n CallExpression) Arguments() ArgumentList {
+	return ArgumentList{n.ChildByFieldName("arguments")}
+}
+
+// Function returns the node in the function field.
+func (n CallExpression) Function() treesitter.Node {
+	return n.ChildByFieldName("function")
+}
+
+// TypeArguments returns the node in the type_arguments field.
+func (n CallExpression) TypeArguments() TypeArguments {
+	return TypeArguments{n.ChildByFieldName("type_arguments")}
+}
+
+// ChannelType wraps nodes of type channel_type.
+type ChannelType struct{ treesitter.Node }
+
+// AsChannelType converts n to ChannelType, reporting whether it is of type channel_type.
+func AsChannelType(n treesitter.Node) (ChannelType, bool) {
+	return ChannelType{n}, n.IsNamed() && n.Type() == "channel_type"
+}
+
+// Value returns the node in the value field.
+func (n ChannelType) Value() treesitter.Node {
+	return n.ChildByFieldName("value")
+}
+
+// CommunicationCase wraps nodes of type communication_case.
+type CommunicationCase struct{ treesitter.Node }
+
+// AsCommunicationCase converts n to CommunicationCase, reporting whether it is of type communication_case.
+func AsCommunicationCase(n treesitter.Node) (CommunicationCase, bool) {
+	return CommunicationCase{n}, n.IsNamed() && n.Type() == "communication_case"
+}
+
+// Communication returns the node in the communication field.
+func (n CommunicationCase) Communication() treesitter.Node {
+	return n.ChildByFieldName("communication")
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n CommunicationCase) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// CompositeLiteral wraps nodes of type composite_literal.
+type CompositeLiteral struct{ treesitter.Node }
+
+// AsCompositeLiteral converts n to CompositeLiteral, reporting whether it is of type composite_literal.
+func AsCompositeLiteral(n treesitter.Node) (CompositeLiteral, bool) {
+	return CompositeLiteral{n}, n.IsNamed() && n.Type() == "composite_literal"
+}
+
+// Body returns the node in the body field.
+func (n CompositeLiteral) Body() LiteralValue {
+	return LiteralValue{n.ChildByFieldName("body")}
+}
+
+// TypeField returns the node in the type field.
+func (n CompositeLiteral) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// ConstDeclaration wraps nodes of type const_declaration.
+type ConstDeclaration struct{ treesitter.Node }
+
+// AsConstDeclaration converts n to ConstDeclaration, reporting whether it is of type const_declaration.
+func AsConstDeclaration(n treesitter.Node) (ConstDeclaration, bool) {
+	return ConstDeclaration{n}, n.IsNamed() && n.Type() == "const_declaration"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ConstDeclaration) Contents() []ConstSpec {
+	var nodes []ConstSpec
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, ConstSpec{c})
+		}
+	}
+	return nodes
+}
+
+// ConstSpec wraps nodes of type const_spec.
+type ConstSpec struct{ treesitter.Node }
+
+// AsConstSpec converts n to ConstSpec, reporting whether it is of type const_spec.
+func AsConstSpec(n treesitter.Node) (ConstSpec, bool) {
+	return ConstSpec{n}, n.IsNamed() && n.Type() == "const_spec"
+}
+
+// Name returns the nodes in the name field.
+func (n ConstSpec) Name() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "name" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeField returns the node in the type field.
+func (n ConstSpec) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// Value returns the node in the value field.
+func (n ConstSpec) Value() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("value")}
+}
+
+// ContinueStatement wraps nodes of type continue_statement.
+type ContinueStatement struct{ treesitter.Node }
+
+// AsContinueStatement converts n to ContinueStatement, reporting whether it is of type continue_statement.
+func AsContinueStatement(n treesitter.Node) (ContinueStatement, bool) {
+	return ContinueStatement{n}, n.IsNamed() && n.Type() == "continue_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ContinueStatement) Content() LabelName {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return LabelName{c}
+		}
+	}
+	return LabelName{}
+}
+
+// DecStatement wraps nodes of type dec_statement.
+type DecStatement struct{ treesitter.Node }
+
+// AsDecStatement converts n to DecStatement, reporting whether it is of type dec_statement.
+func AsDecStatement(n treesitter.Node) (DecStatement, bool) {
+	return DecStatement{n}, n.IsNamed() && n.Type() == "dec_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n DecStatement) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// DefaultCase wraps nodes of type default_case.
+type DefaultCase struct{ treesitter.Node }
+
+// AsDefaultCase converts n to DefaultCase, reporting whether it is of type default_case.
+func AsDefaultCase(n treesitter.Node) (DefaultCase, bool) {
+	return DefaultCase{n}, n.IsNamed() && n.Type() == "default_case"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n DefaultCase) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// DeferStatement wraps nodes of type defer_statement.
+type DeferStatement struct{ treesitter.Node }
+
+// AsDeferStatement converts n to DeferStatement, reporting whether it is of type defer_statement.
+func AsDeferStatement(n treesitter.Node) (DeferStatement, bool) {
+	return DeferStatement{n}, n.IsNamed() && n.Type() == "defer_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n DeferStatement) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// Dot wraps nodes of type dot.
+type Dot struct{ treesitter.Node }
+
+// AsDot converts n to Dot, reporting whether it is of type dot.
+func AsDot(n treesitter.Node) (Dot, bool) {
+	return Dot{n}, n.IsNamed() && n.Type() == "dot"
+}
+
+// EmptyStatement wraps nodes of type empty_statement.
+type EmptyStatement struct{ treesitter.Node }
+
+// AsEmptyStatement converts n to EmptyStatement, reporting whether it is of type empty_statement.
+func AsEmptyStatement(n treesitter.Node) (EmptyStatement, bool) {
+	return EmptyStatement{n}, n.IsNamed() && n.Type() == "empty_statement"
+}
+
+// ExpressionCase wraps nodes of type expression_case.
+type ExpressionCase struct{ treesitter.Node }
+
+// AsExpressionCase converts n to ExpressionCase, reporting whether it is of type expression_case.
+func AsExpressionCase(n treesitter.Node) (ExpressionCase, bool) {
+	return ExpressionCase{n}, n.IsNamed() && n.Type() == "expression_case"
+}
+
+// Value returns the node in the value field.
+func (n ExpressionCase) Value() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("value")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ExpressionCase) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ExpressionList wraps nodes of type expression_list.
+type ExpressionList struct{ treesitter.Node }
+
+// AsExpressionList converts n to ExpressionList, reporting whether it is of type expression_list.
+func AsExpressionList(n treesitter.Node) (ExpressionList, bool) {
+	return ExpressionList{n}, n.IsNamed() && n.Type() == "expression_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ExpressionList) Contents() []Expression {
+	var nodes []Expression
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, Expression{c})
+		}
+	}
+	return nodes
+}
+
+// ExpressionStatement wraps nodes of type expression_statement.
+type ExpressionStatement struct{ treesitter.Node }
+
+// AsExpressionStatement converts n to ExpressionStatement, reporting whether it is of type expression_statement.
+func AsExpressionStatement(n treesitter.Node) (ExpressionStatement, bool) {
+	return ExpressionStatement{n}, n.IsNamed() && n.Type() == "expression_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ExpressionStatement) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// ExpressionSwitchStatement wraps nodes of type expression_switch_statement.
+type ExpressionSwitchStatement struct{ treesitter.Node }
+
+// AsExpressionSwitchStatement converts n to ExpressionSwitchStatement, reporting whether it is of type expression_switch_statement.
+func AsExpressionSwitchStatement(n treesitter.Node) (ExpressionSwitchStatement, bool) {
+	return ExpressionSwitchStatement{n}, n.IsNamed() && n.Type() == "expression_switch_statement"
+}
+
+// Initializer returns the node in the initializer field.
+func (n ExpressionSwitchStatement) Initializer() SimpleStatement {
+	return SimpleStatement{n.ChildByFieldName("initializer")}
+}
+
+// Value returns the node in the value field.
+func (n ExpressionSwitchStatement) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ExpressionSwitchStatement) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// FallthroughStatement wraps nodes of type fallthrough_statement.
+type FallthroughStatement struct{ treesitter.Node }
+
+// AsFallthroughStatement converts n to FallthroughStatement, reporting whether it is of type fallthrough_statement.
+func AsFallthroughStatement(n treesitter.Node) (FallthroughStatement, bool) {
+	return FallthroughStatement{n}, n.IsNamed() && n.Type() == "fallthrough_statement"
+}
+
+// FieldDeclaration wraps nodes of type field_declaration.
+type FieldDeclaration struct{ treesitter.Node }
+
+// AsFieldDeclaration converts n to FieldDeclaration, reporting whether it is of type field_declaration.
+func AsFieldDeclaration(n treesitter.Node) (FieldDeclaration, bool) {
+	return FieldDeclaration{n}, n.IsNamed() && n.Type() == "field_declaration"
+}
+
+// Name returns the nodes in the name field.
+func (n FieldDeclaration) Name() []FieldIdentifier {
+	var nodes []FieldIdentifier
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "name" {
+			nodes = append(nodes, FieldIdentifier{c})
+		}
+	}
+	return nodes
+}
+
+// Tag returns the node in the tag field.
+func (n FieldDeclaration) Tag() treesitter.Node {
+	return n.ChildByFieldName("tag")
+}
+
+// TypeField returns the node in the type field.
+func (n FieldDeclaration) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// FieldDeclarationList wraps nodes of type field_declaration_list.
+type FieldDeclarationList struct{ treesitter.Node }
+
+// AsFieldDeclarationList converts n to FieldDeclarationList, reporting whether it is of type field_declaration_list.
+func AsFieldDeclarationList(n treesitter.Node) (FieldDeclarationList, bool) {
+	return FieldDeclarationList{n}, n.IsNamed() && n.Type() == "field_declaration_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n FieldDeclarationList) Contents() []FieldDeclaration {
+	var nodes []FieldDeclaration
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, FieldDeclaration{c})
+		}
+	}
+	return nodes
+}
+
+// ForClause wraps nodes of type for_clause.
+type ForClause struct{ treesitter.Node }
+
+// AsForClause converts n to ForClause, reporting whether it is of type for_clause.
+func AsForClause(n treesitter.Node) (ForClause, bool) {
+	return ForClause{n}, n.IsNamed() && n.Type() == "for_clause"
+}
+
+// Condition returns the node in the condition field.
+func (n ForClause) Condition() Expression {
+	return Expression{n.ChildByFieldName("condition")}
+}
+
+// Initializer returns the node in the initializer field.
+func (n ForClause) Initializer() SimpleStatement {
+	return SimpleStatement{n.ChildByFieldName("initializer")}
+}
+
+// Update returns the node in the update field.
+func (n ForClause) Update() SimpleStatement {
+	return SimpleStatement{n.ChildByFieldName("update")}
+}
+
+// ForStatement wraps nodes of type for_statement.
+type ForStatement struct{ treesitter.Node }
+
+// AsForStatement converts n to ForStatement, reporting whether it is of type for_statement.
+func AsForStatement(n treesitter.Node) (ForStatement, bool) {
+	return ForStatement{n}, n.IsNamed() && n.Type() == "for_statement"
+}
+
+// Body returns the node in the body field.
+func (n ForStatement) Body() Block {
+	return Block{n.ChildByFieldName("body")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ForStatement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// FuncLiteral wraps nodes of type func_literal.
+type FuncLiteral struct{ treesitter.Node }
+
+// AsFuncLiteral converts n to FuncLiteral, reporting whether it is of type func_literal.
+func AsFuncLiteral(n treesitter.Node) (FuncLiteral, bool) {
+	return FuncLiteral{n}, n.IsNamed() && n.Type() == "func_literal"
+}
+
+// Body returns the node in the body field.
+func (n FuncLiteral) Body() Block {
+	return Block{n.ChildByFieldName("body")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n FuncLiteral) Parameters() ParameterList {
+	return ParameterList{n.ChildByFieldName("parameters")}
+}
+
+// Result returns the node in the result field.
+func (n FuncLiteral) Result() treesitter.Node {
+	return n.ChildByFieldName("result")
+}
+
+// FunctionDeclaration wraps nodes of type function_declaration.
+type FunctionDeclaration struct{ treesitter.Node }
+
+// AsFunctionDeclaration converts n to FunctionDeclaration, reporting whether it is of type function_declaration.
+func AsFunctionDeclaration(n treesitter.Node) (FunctionDeclaration, bool) {
+	return FunctionDeclaration{n}, n.IsNamed() && n.Type() == "function_declaration"
+}
+
+// Body returns the node in the body field.
+func (n FunctionDeclaration) Body() Block {
+	return Block{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n FunctionDeclaration) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n FunctionDeclaration) Parameters() ParameterList {
+	return ParameterList{n.ChildByFieldName("parameters")}
+}
+
+// Result returns the node in the result field.
+func (n FunctionDeclaration) Result() treesitter.Node {
+	return n.ChildByFieldName("result")
+}
+
+// TypeParameters returns the node in the type_parameters field.
+func (n FunctionDeclaration) TypeParameters() TypeParameterList {
+	return TypeParameterList{n.ChildByFieldName("type_parameters")}
+}
+
+// FunctionType wraps nodes of type function_type.
+type FunctionType struct{ treesitter.Node }
+
+// AsFunctionType converts n to FunctionType, reporting whether it is of type function_type.
+func AsFunctionType(n treesitter.Node) (FunctionType, bool) {
+	return FunctionType{n}, n.IsNamed() && n.Type() == "function_type"
+}
+
+// Parameters returns the node in the parameters field.
+func (n FunctionType) Parameters() ParameterList {
+	return ParameterList{n.ChildByFieldName("parameters")}
+}
+
+// Result returns the node in the result field.
+func (n FunctionType) Result() treesitter.Node {
+	return n.ChildByFieldName("result")
+}
+
+// GenericType wraps nodes of type generic_type.
+type GenericType struct{ treesitter.Node }
+
+// AsGenericType converts n to GenericType, reporting whether it is of type generic_type.
+func AsGenericType(n treesitter.Node) (GenericType, bool) {
+	return GenericType{n}, n.IsNamed() && n.Type() == "generic_type"
+}
+
+// TypeField returns the node in the type field.
+func (n GenericType) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// TypeArguments returns the node in the type_arguments field.
+func (n GenericType) TypeArguments() TypeArguments {
+	return TypeArguments{n.ChildByFieldName("type_arguments")}
+}
+
+// GoStatement wraps nodes of type go_statement.
+type GoStatement struct{ treesitter.Node }
+
+// AsGoStatement converts n to GoStatement, reporting whether it is of type go_statement.
+func AsGoStatement(n treesitter.Node) (GoStatement, bool) {
+	return GoStatement{n}, n.IsNamed() && n.Type() == "go_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n GoStatement) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// GotoStatement wraps nodes of type goto_statement.
+type GotoStatement struct{ treesitter.Node }
+
+// AsGotoStatement converts n to GotoStatement, reporting whether it is of type goto_statement.
+func AsGotoStatement(n treesitter.Node) (GotoStatement, bool) {
+	return GotoStatement{n}, n.IsNamed() && n.Type() == "goto_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n GotoStatement) Content() LabelName {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return LabelName{c}
+		}
+	}
+	return LabelName{}
+}
+
+// IfStatement wraps nodes of type if_statement.
+type IfStatement struct{ treesitter.Node }
+
+// AsIfStatement converts n to IfStatement, reporting whether it is of type if_statement.
+func AsIfStatement(n treesitter.Node) (IfStatement, bool) {
+	return IfStatement{n}, n.IsNamed() && n.Type() == "if_statement"
+}
+
+// Alternative returns the node in the alternative field.
+func (n IfStatement) Alternative() treesitter.Node {
+	return n.ChildByFieldName("alternative")
+}
+
+// Condition returns the node in the condition field.
+func (n IfStatement) Condition() Expression {
+	return Expression{n.ChildByFieldName("condition")}
+}
+
+// Consequence returns the node in the consequence field.
+func (n IfStatement) Consequence() Block {
+	return Block{n.ChildByFieldName("consequence")}
+}
+
+// Initializer returns the node in the initializer field.
+func (n IfStatement) Initializer() SimpleStatement {
+	return SimpleStatement{n.ChildByFieldName("initializer")}
+}
+
+// ImplicitLengthArrayType wraps nodes of type implicit_length_array_type.
+type ImplicitLengthArrayType struct{ treesitter.Node }
+
+// AsImplicitLengthArrayType converts n to ImplicitLengthArrayType, reporting whether it is of type implicit_length_array_type.
+func AsImplicitLengthArrayType(n treesitter.Node) (ImplicitLengthArrayType, bool) {
+	return ImplicitLengthArrayType{n}, n.IsNamed() && n.Type() == "implicit_length_array_type"
+}
+
+// Element returns the node in the element field.
+func (n ImplicitLengthArrayType) Element() treesitter.Node {
+	return n.ChildByFieldName("element")
+}
+
+// ImportDeclaration wraps nodes of type import_declaration.
+type ImportDeclaration struct{ treesitter.Node }
+
+// AsImportDeclaration converts n to ImportDeclaration, reporting whether it is of type import_declaration.
+func AsImportDeclaration(n treesitter.Node) (ImportDeclaration, bool) {
+	return ImportDeclaration{n}, n.IsNamed() && n.Type() == "import_declaration"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ImportDeclaration) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// ImportSpec wraps nodes of type import_spec.
+type ImportSpec struct{ treesitter.Node }
+
+// AsImportSpec converts n to ImportSpec, reporting whether it is of type import_spec.
+func AsImportSpec(n treesitter.Node) (ImportSpec, bool) {
+	return ImportSpec{n}, n.IsNamed() && n.Type() == "import_spec"
+}
+
+// Name returns the node in the name field.
+func (n ImportSpec) Name() treesitter.Node {
+	return n.ChildByFieldName("name")
+}
+
+// PathField returns the node in the path field.
+func (n ImportSpec) PathField() treesitter.Node {
+	return n.ChildByFieldName("path")
+}
+
+// ImportSpecList wraps nodes of type import_spec_list.
+type ImportSpecList struct{ treesitter.Node }
+
+// AsImportSpecList converts n to ImportSpecList, reporting whether it is of type import_spec_list.
+func AsImportSpecList(n treesitter.Node) (ImportSpecList, bool) {
+	return ImportSpecList{n}, n.IsNamed() && n.Type() == "import_spec_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ImportSpecList) Contents() []ImportSpec {
+	var nodes []ImportSpec
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, ImportSpec{c})
+		}
+	}
+	return nodes
+}
+
+// IncStatement wraps nodes of type inc_statement.
+type IncStatement struct{ treesitter.Node }
+
+// AsIncStatement converts n to IncStatement, reporting whether it is of type inc_statement.
+func AsIncStatement(n treesitter.Node) (IncStatement, bool) {
+	return IncStatement{n}, n.IsNamed() && n.Type() == "inc_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n IncStatement) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// IndexExpression wraps nodes of type index_expression.
+type IndexExpression struct{ treesitter.Node }
+
+// AsIndexExpression converts n to IndexExpression, reporting whether it is of type index_expression.
+func AsIndexExpression(n treesitter.Node) (IndexExpression, bool) {
+	return IndexExpression{n}, n.IsNamed() && n.Type() == "index_expression"
+}
+
+// Index returns the node in the index field.
+func (n IndexExpression) Index() Expression {
+	return Expression{n.ChildByFieldName("index")}
+}
+
+// Operand returns the node in the operand field.
+func (n IndexExpression) Operand() Expression {
+	return Expression{n.ChildByFieldName("operand")}
+}
+
+// InterfaceType wraps nodes of type interface_type.
+type InterfaceType struct{ treesitter.Node }
+
+// AsInterfaceType converts n to InterfaceType, reporting whether it is of type interface_type.
+func AsInterfaceType(n treesitter.Node) (InterfaceType, bool) {
+	return InterfaceType{n}, n.IsNamed() && n.Type() == "interface_type"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n InterfaceType) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// InterpretedStringLiteral wraps nodes of type interpreted_string_literal.
+type InterpretedStringLiteral struct{ treesitter.Node }
+
+// AsInterpretedStringLiteral converts n to InterpretedStringLiteral, reporting whether it is of type interpreted_string_literal.
+func AsInterpretedStringLiteral(n treesitter.Node) (InterpretedStringLiteral, bool) {
+	return InterpretedStringLiteral{n}, n.IsNamed() && n.Type() == "interpreted_string_literal"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n InterpretedStringLiteral) Contents() []EscapeSequence {
+	var nodes []EscapeSequence
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, EscapeSequence{c})
+		}
+	}
+	return nodes
+}
+
+// KeyedElement wraps nodes of type keyed_element.
+type KeyedElement struct{ treesitter.Node }
+
+// AsKeyedElement converts n to KeyedElement, reporting whether it is of type keyed_element.
+func AsKeyedElement(n treesitter.Node) (KeyedElement, bool) {
+	return KeyedElement{n}, n.IsNamed() && n.Type() == "keyed_element"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n KeyedElement) Contents() []LiteralElement {
+	var nodes []LiteralElement
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, LiteralElement{c})
+		}
+	}
+	return nodes
+}
+
+// LabeledStatement wraps nodes of type labeled_statement.
+type LabeledStatement struct{ treesitter.Node }
+
+// AsLabeledStatement converts n to LabeledStatement, reporting whether it is of type labeled_statement.
+func AsLabeledStatement(n treesitter.Node) (LabeledStatement, bool) {
+	return LabeledStatement{n}, n.IsNamed() && n.Type() == "labeled_statement"
+}
+
+// Label returns the node in the label field.
+func (n LabeledStatement) Label() LabelName {
+	return LabelName{n.ChildByFieldName("label")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n LabeledStatement) Content() Statement {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Statement{c}
+		}
+	}
+	return Statement{}
+}
+
+// LiteralElement wraps nodes of type literal_element.
+type LiteralElement struct{ treesitter.Node }
+
+// AsLiteralElement converts n to LiteralElement, reporting whether it is of type literal_element.
+func AsLiteralElement(n treesitter.Node) (LiteralElement, bool) {
+	return LiteralElement{n}, n.IsNamed() && n.Type() == "literal_element"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n LiteralElement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// LiteralValue wraps nodes of type literal_value.
+type LiteralValue struct{ treesitter.Node }
+
+// AsLiteralValue converts n to LiteralValue, reporting whether it is of type literal_value.
+func AsLiteralValue(n treesitter.Node) (LiteralValue, bool) {
+	return LiteralValue{n}, n.IsNamed() && n.Type() == "literal_value"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n LiteralValue) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// MapType wraps nodes of type map_type.
+type MapType struct{ treesitter.Node }
+
+// AsMapType converts n to MapType, reporting whether it is of type map_type.
+func AsMapType(n treesitter.Node) (MapType, bool) {
+	return MapType{n}, n.IsNamed() && n.Type() == "map_type"
+}
+
+// Key returns the node in the key field.
+func (n MapType) Key() treesitter.Node {
+	return n.ChildByFieldName("key")
+}
+
+// Value returns the node in the value field.
+func (n MapType) Value() treesitter.Node {
+	return n.ChildByFieldName("value")
+}
+
+// MethodDeclaration wraps nodes of type method_declaration.
+type MethodDeclaration struct{ treesitter.Node }
+
+// AsMethodDeclaration converts n to MethodDeclaration, reporting whether it is of type method_declaration.
+func AsMethodDeclaration(n treesitter.Node) (MethodDeclaration, bool) {
+	return MethodDeclaration{n}, n.IsNamed() && n.Type() == "method_declaration"
+}
+
+// Body returns the node in the body field.
+func (n MethodDeclaration) Body() Block {
+	return Block{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n MethodDeclaration) Name() FieldIdentifier {
+	return FieldIdentifier{n.ChildByFieldName("name")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n MethodDeclaration) Parameters() ParameterList {
+	return ParameterList{n.ChildByFieldName("parameters")}
+}
+
+// Receiver returns the node in the receiver field.
+func (n MethodDeclaration) Receiver() ParameterList {
+	return ParameterList{n.ChildByFieldName("receiver")}
+}
+
+// Result returns the node in the result field.
+func (n MethodDeclaration) Result() treesitter.Node {
+	return n.ChildByFieldName("result")
+}
+
+// MethodElem wraps nodes of type method_elem.
+type MethodElem struct{ treesitter.Node }
+
+// AsMethodElem converts n to MethodElem, reporting whether it is of type method_elem.
+func AsMethodElem(n treesitter.Node) (MethodElem, bool) {
+	return MethodElem{n}, n.IsNamed() && n.Type() == "method_elem"
+}
+
+// Name returns the node in the name field.
+func (n MethodElem) Name() FieldIdentifier {
+	return FieldIdentifier{n.ChildByFieldName("name")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n MethodElem) Parameters() ParameterList {
+	return ParameterList{n.ChildByFieldName("parameters")}
+}
+
+// Result returns the node in the result field.
+func (n MethodElem) Result() treesitter.Node {
+	return n.ChildByFieldName("result")
+}
+
+// NegatedType wraps nodes of type negated_type.
+type NegatedType struct{ treesitter.Node }
+
+// AsNegatedType converts n to NegatedType, reporting whether it is of type negated_type.
+func AsNegatedType(n treesitter.Node) (NegatedType, bool) {
+	return NegatedType{n}, n.IsNamed() && n.Type() == "negated_type"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n NegatedType) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// PackageClause wraps nodes of type package_clause.
+type PackageClause struct{ treesitter.Node }
+
+// AsPackageClause converts n to PackageClause, reporting whether it is of type package_clause.
+func AsPackageClause(n treesitter.Node) (PackageClause, bool) {
+	return PackageClause{n}, n.IsNamed() && n.Type() == "package_clause"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n PackageClause) Content() PackageIdentifier {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return PackageIdentifier{c}
+		}
+	}
+	return PackageIdentifier{}
+}
+
+// ParameterDeclaration wraps nodes of type parameter_declaration.
+type ParameterDeclaration struct{ treesitter.Node }
+
+// AsParameterDeclaration converts n to ParameterDeclaration, reporting whether it is of type parameter_declaration.
+func AsParameterDeclaration(n treesitter.Node) (ParameterDeclaration, bool) {
+	return ParameterDeclaration{n}, n.IsNamed() && n.Type() == "parameter_declaration"
+}
+
+// Name returns the nodes in the name field.
+func (n ParameterDeclaration) Name() []Identifier {
+	var nodes []Identifier
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "name" {
+			nodes = append(nodes, Identifier{c})
+		}
+	}
+	return nodes
+}
+
+// TypeField returns the node in the type field.
+func (n ParameterDeclaration) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// ParameterList wraps nodes of type parameter_list.
+type ParameterList struct{ treesitter.Node }
+
+// AsParameterList converts n to ParameterList, reporting whether it is of type parameter_list.
+func AsParameterList(n treesitter.Node) (ParameterList, bool) {
+	return ParameterList{n}, n.IsNamed() && n.Type() == "parameter_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ParameterList) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ParenthesizedExpression wraps nodes of type parenthesized_expression.
+type ParenthesizedExpression struct{ treesitter.Node }
+
+// AsParenthesizedExpression converts n to ParenthesizedExpression, reporting whether it is of type parenthesized_expression.
+func AsParenthesizedExpression(n treesitter.Node) (ParenthesizedExpression, bool) {
+	return ParenthesizedExpression{n}, n.IsNamed() && n.Type() == "parenthesized_expression"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ParenthesizedExpression) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// ParenthesizedType wraps nodes of type parenthesized_type.
+type ParenthesizedType struct{ treesitter.Node }
+
+// AsParenthesizedType converts n to ParenthesizedType, reporting whether it is of type parenthesized_type.
+func AsParenthesizedType(n treesitter.Node) (ParenthesizedType, bool) {
+	return ParenthesizedType{n}, n.IsNamed() && n.Type() == "parenthesized_type"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ParenthesizedType) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// PointerType wraps nodes of type pointer_type.
+type PointerType struct{ treesitter.Node }
+
+// AsPointerType converts n to PointerType, reporting whether it is of type pointer_type.
+func AsPointerType(n treesitter.Node) (PointerType, bool) {
+	return PointerType{n}, n.IsNamed() && n.Type() == "pointer_type"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n PointerType) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// QualifiedType wraps nodes of type qualified_type.
+type QualifiedType struct{ treesitter.Node }
+
+// AsQualifiedType converts n to QualifiedType, reporting whether it is of type qualified_type.
+func AsQualifiedType(n treesitter.Node) (QualifiedType, bool) {
+	return QualifiedType{n}, n.IsNamed() && n.Type() == "qualified_type"
+}
+
+// Name returns the node in the name field.
+func (n QualifiedType) Name() TypeIdentifier {
+	return TypeIdentifier{n.ChildByFieldName("name")}
+}
+
+// Package returns the node in the package field.
+func (n QualifiedType) Package() PackageIdentifier {
+	return PackageIdentifier{n.ChildByFieldName("package")}
+}
+
+// RangeClause wraps nodes of type range_clause.
+type RangeClause struct{ treesitter.Node }
+
+// AsRangeClause converts n to RangeClause, reporting whether it is of type range_clause.
+func AsRangeClause(n treesitter.Node) (RangeClause, bool) {
+	return RangeClause{n}, n.IsNamed() && n.Type() == "range_clause"
+}
+
+// Left returns the node in the left field.
+func (n RangeClause) Left() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("left")}
+}
+
+// Right returns the node in the right field.
+func (n RangeClause) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// ReceiveStatement wraps nodes of type receive_statement.
+type ReceiveStatement struct{ treesitter.Node }
+
+// AsReceiveStatement converts n to ReceiveStatement, reporting whether it is of type receive_statement.
+func AsReceiveStatement(n treesitter.Node) (ReceiveStatement, bool) {
+	return ReceiveStatement{n}, n.IsNamed() && n.Type() == "receive_statement"
+}
+
+// Left returns the node in the left field.
+func (n ReceiveStatement) Left() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("left")}
+}
+
+// Right returns the node in the right field.
+func (n ReceiveStatement) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// ReturnStatement wraps nodes of type return_statement.
+type ReturnStatement struct{ treesitter.Node }
+
+// AsReturnStatement converts n to ReturnStatement, reporting whether it is of type return_statement.
+func AsReturnStatement(n treesitter.Node) (ReturnStatement, bool) {
+	return ReturnStatement{n}, n.IsNamed() && n.Type() == "return_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ReturnStatement) Content() ExpressionList {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return ExpressionList{c}
+		}
+	}
+	return ExpressionList{}
+}
+
+// SelectStatement wraps nodes of type select_statement.
+type SelectStatement struct{ treesitter.Node }
+
+// AsSelectStatement converts n to SelectStatement, reporting whether it is of type select_statement.
+func AsSelectStatement(n treesitter.Node) (SelectStatement, bool) {
+	return SelectStatement{n}, n.IsNamed() && n.Type() == "select_statement"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n SelectStatement) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// SelectorExpression wraps nodes of type selector_expression.
+type SelectorExpression struct{ treesitter.Node }
+
+// AsSelectorExpression converts n to SelectorExpression, reporting whether it is of type selector_expression.
+func AsSelectorExpression(n treesitter.Node) (SelectorExpression, bool) {
+	return SelectorExpression{n}, n.IsNamed() && n.Type() == "selector_expression"
+}
+
+// Field returns the node in the field field.
+func (n SelectorExpression) Field() FieldIdentifier {
+	return FieldIdentifier{n.ChildByFieldName("field")}
+}
+
+// Operand returns the node in the operand field.
+func (n SelectorExpression) Operand() Expression {
+	return Expression{n.ChildByFieldName("operand")}
+}
+
+// SendStatement wraps nodes of type send_statement.
+type SendStatement struct{ treesitter.Node }
+
+// AsSendStatement converts n to SendStatement, reporting whether it is of type send_statement.
+func AsSendStatement(n treesitter.Node) (SendStatement, bool) {
+	return SendStatement{n}, n.IsNamed() && n.Type() == "send_statement"
+}
+
+// Channel returns the node in the channel field.
+func (n SendStatement) Channel() Expression {
+	return Expression{n.ChildByFieldName("channel")}
+}
+
+// Value returns the node in the value field.
+func (n SendStatement) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// ShortVarDeclaration wraps nodes of type short_var_declaration.
+type ShortVarDeclaration struct{ treesitter.Node }
+
+// AsShortVarDeclaration converts n to ShortVarDeclaration, reporting whether it is of type short_var_declaration.
+func AsShortVarDeclaration(n treesitter.Node) (ShortVarDeclaration, bool) {
+	return ShortVarDeclaration{n}, n.IsNamed() && n.Type() == "short_var_declaration"
+}
+
+// Left returns the node in the left field.
+func (n ShortVarDeclaration) Left() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("left")}
+}
+
+// Right returns the node in the right field.
+func (n ShortVarDeclaration) Right() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("right")}
+}
+
+// SliceExpression wraps nodes of type slice_expression.
+type SliceExpression struct{ treesitter.Node }
+
+// AsSliceExpression converts n to SliceExpression, reporting whether it is of type slice_expression.
+func AsSliceExpression(n treesitter.Node) (SliceExpression, bool) {
+	return SliceExpression{n}, n.IsNamed() && n.Type() == "slice_expression"
+}
+
+// Capacity returns the node in the capacity field.
+func (n SliceExpression) Capacity() Expression {
+	return Expression{n.ChildByFieldName("capacity")}
+}
+
+// End returns the node in the end field.
+func (n SliceExpression) End() Expression {
+	return Expression{n.ChildByFieldName("end")}
+}
+
+// Operand returns the node in the operand field.
+func (n SliceExpression) Operand() Expression {
+	return Expression{n.ChildByFieldName("operand")}
+}
+
+// Start returns the node in the start field.
+func (n SliceExpression) Start() Expression {
+	return Expression{n.ChildByFieldName("start")}
+}
+
+// SliceType wraps nodes of type slice_type.
+type SliceType struct{ treesitter.Node }
+
+// AsSliceType converts n to SliceType, reporting whether it is of type slice_type.
+func AsSliceType(n treesitter.Node) (SliceType, bool) {
+	return SliceType{n}, n.IsNamed() && n.Type() == "slice_type"
+}
+
+// Element returns the node in the element field.
+func (n SliceType) Element() treesitter.Node {
+	return n.ChildByFieldName("element")
+}
+
+// SourceFile wraps nodes of type source_file.
+type SourceFile struct{ treesitter.Node }
+
+// AsSourceFile converts n to SourceFile, reporting whether it is of type source_file.
+func AsSourceFile(n treesitter.Node) (SourceFile, bool) {
+	return SourceFile{n}, n.IsNamed() && n.Type() == "source_file"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n SourceFile) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// StructType wraps nodes of type struct_type.
+type StructType struct{ treesitter.Node }
+
+// AsStructType converts n to StructType, reporting whether it is of type struct_type.
+func AsStructType(n treesitter.Node) (StructType, bool) {
+	return StructType{n}, n.IsNamed() && n.Type() == "struct_type"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n StructType) Content() FieldDeclarationList {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return FieldDeclarationList{c}
+		}
+	}
+	return FieldDeclarationList{}
+}
+
+// TypeAlias wraps nodes of type type_alias.
+type TypeAlias struct{ treesitter.Node }
+
+// AsTypeAlias converts n to TypeAlias, reporting whether it is of type type_alias.
+func AsTypeAlias(n treesitter.Node) (TypeAlias, bool) {
+	return TypeAlias{n}, n.IsNamed() && n.Type() == "type_alias"
+}
+
+// Name returns the node in the name field.
+func (n TypeAlias) Name() TypeIdentifier {
+	return TypeIdentifier{n.ChildByFieldName("name")}
+}
+
+// TypeField returns the node in the type field.
+func (n TypeAlias) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// TypeArguments wraps nodes of type type_arguments.
+type TypeArguments struct{ treesitter.Node }
+
+// AsTypeArguments converts n to TypeArguments, reporting whether it is of type type_arguments.
+func AsTypeArguments(n treesitter.Node) (TypeArguments, bool) {
+	return TypeArguments{n}, n.IsNamed() && n.Type() == "type_arguments"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeArguments) Contents() []TypeElem {
+	var nodes []TypeElem
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, TypeElem{c})
+		}
+	}
+	return nodes
+}
+
+// TypeAssertionExpression wraps nodes of type type_assertion_expression.
+type TypeAssertionExpression struct{ treesitter.Node }
+
+// AsTypeAssertionExpression converts n to TypeAssertionExpression, reporting whether it is of type type_assertion_expression.
+func AsTypeAssertionExpression(n treesitter.Node) (TypeAssertionExpression, bool) {
+	return TypeAssertionExpression{n}, n.IsNamed() && n.Type() == "type_assertion_expression"
+}
+
+// Operand returns the node in the operand field.
+func (n TypeAssertionExpression) Operand() Expression {
+	return Expression{n.ChildByFieldName("operand")}
+}
+
+// TypeField returns the node in the type field.
+func (n TypeAssertionExpression) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// TypeCase wraps nodes of type type_case.
+type TypeCase struct{ treesitter.Node }
+
+// AsTypeCase converts n to TypeCase, reporting whether it is of type type_case.
+func AsTypeCase(n treesitter.Node) (TypeCase, bool) {
+	return TypeCase{n}, n.IsNamed() && n.Type() == "type_case"
+}
+
+// TypeField returns the nodes in the type field.
+func (n TypeCase) TypeField() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "type" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeCase) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeConstraint wraps nodes of type type_constraint.
+type TypeConstraint struct{ treesitter.Node }
+
+// AsTypeConstraint converts n to TypeConstraint, reporting whether it is of type type_constraint.
+func AsTypeConstraint(n treesitter.Node) (TypeConstraint, bool) {
+	return TypeConstraint{n}, n.IsNamed() && n.Type() == "type_constraint"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeConstraint) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeConversionExpression wraps nodes of type type_conversion_expression.
+type TypeConversionExpression struct{ treesitter.Node }
+
+// AsTypeConversionExpression converts n to TypeConversionExpression, reporting whether it is of type type_conversion_expression.
+func AsTypeConversionExpression(n treesitter.Node) (TypeConversionExpression, bool) {
+	return TypeConversionExpression{n}, n.IsNamed() && n.Type() == "type_conversion_expression"
+}
+
+// Operand returns the node in the operand field.
+func (n TypeConversionExpression) Operand() Expression {
+	return Expression{n.ChildByFieldName("operand")}
+}
+
+// TypeField returns the node in the type field.
+func (n TypeConversionExpression) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// TypeDeclaration wraps nodes of type type_declaration.
+type TypeDeclaration struct{ treesitter.Node }
+
+// AsTypeDeclaration converts n to TypeDeclaration, reporting whether it is of type type_declaration.
+func AsTypeDeclaration(n treesitter.Node) (TypeDeclaration, bool) {
+	return TypeDeclaration{n}, n.IsNamed() && n.Type() == "type_declaration"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeDeclaration) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeElem wraps nodes of type type_elem.
+type TypeElem struct{ treesitter.Node }
+
+// AsTypeElem converts n to TypeElem, reporting whether it is of type type_elem.
+func AsTypeElem(n treesitter.Node) (TypeElem, bool) {
+	return TypeElem{n}, n.IsNamed() && n.Type() == "type_elem"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeElem) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeInstantiationExpression wraps nodes of type type_instantiation_expression.
+type TypeInstantiationExpression struct{ treesitter.Node }
+
+// AsTypeInstantiationExpression converts n to TypeInstantiationExpression, reporting whether it is of type type_instantiation_expression.
+func AsTypeInstantiationExpression(n treesitter.Node) (TypeInstantiationExpression, bool) {
+	return TypeInstantiationExpression{n}, n.IsNamed() && n.Type() == "type_instantiation_expression"
+}
+
+// TypeField returns the node in the type field.
+func (n TypeInstantiationExpression) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeInstantiationExpression) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TypeParameterDeclaration wraps nodes of type type_parameter_declaration.
+type TypeParameterDeclaration struct{ treesitter.Node }
+
+// AsTypeParameterDeclaration converts n to TypeParameterDeclaration, reporting whether it is of type type_parameter_declaration.
+func AsTypeParameterDeclaration(n treesitter.Node) (TypeParameterDeclaration, bool) {
+	return TypeParameterDeclaration{n}, n.IsNamed() && n.Type() == "type_parameter_declaration"
+}
+
+// Name returns the nodes in the name field.
+func (n TypeParameterDeclaration) Name() []Identifier {
+	var nodes []Identifier
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "name" {
+			nodes = append(nodes, Identifier{c})
+		}
+	}
+	return nodes
+}
+
+// TypeField returns the node in the type field.
+func (n TypeParameterDeclaration) TypeField() TypeConstraint {
+	return TypeConstraint{n.ChildByFieldName("type")}
+}
+
+// TypeParameterList wraps nodes of type type_parameter_list.
+type TypeParameterList struct{ treesitter.Node }
+
+// AsTypeParameterList converts n to TypeParameterList, reporting whether it is of type type_parameter_list.
+func AsTypeParameterList(n treesitter.Node) (TypeParameterList, bool) {
+	return TypeParameterList{n}, n.IsNamed() && n.Type() == "type_parameter_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeParameterList) Contents() []TypeParameterDeclaration {
+	var nodes []TypeParameterDeclaration
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, TypeParameterDeclaration{c})
+		}
+	}
+	return nodes
+}
+
+// TypeSpec wraps nodes of type type_spec.
+type TypeSpec struct{ treesitter.Node }
+
+// AsTypeSpec converts n to TypeSpec, reporting whether it is of type type_spec.
+func AsTypeSpec(n treesitter.Node) (TypeSpec, bool) {
+	return TypeSpec{n}, n.IsNamed() && n.Type() == "type_spec"
+}
+
+// Name returns the node in the name field.
+func (n TypeSpec) Name() TypeIdentifier {
+	return TypeIdentifier{n.ChildByFieldName("name")}
+}
+
+// TypeField returns the node in the type field.
+func (n TypeSpec) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// TypeParameters returns the node in the type_parameters field.
+func (n TypeSpec) TypeParameters() TypeParameterList {
+	return TypeParameterList{n.ChildByFieldName("type_parameters")}
+}
+
+// TypeSwitchStatement wraps nodes of type type_switch_statement.
+type TypeSwitchStatement struct{ treesitter.Node }
+
+// AsTypeSwitchStatement converts n to TypeSwitchStatement, reporting whether it is of type type_switch_statement.
+func AsTypeSwitchStatement(n treesitter.Node) (TypeSwitchStatement, bool) {
+	return TypeSwitchStatement{n}, n.IsNamed() && n.Type() == "type_switch_statement"
+}
+
+// Alias returns the node in the alias field.
+func (n TypeSwitchStatement) Alias() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("alias")}
+}
+
+// Initializer returns the node in the initializer field.
+func (n TypeSwitchStatement) Initializer() SimpleStatement {
+	return SimpleStatement{n.ChildByFieldName("initializer")}
+}
+
+// Value returns the node in the value field.
+func (n TypeSwitchStatement) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TypeSwitchStatement) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// UnaryExpression wraps nodes of type unary_expression.
+type UnaryExpression struct{ treesitter.Node }
+
+// AsUnaryExpression converts n to UnaryExpression, reporting whether it is of type unary_expression.
+func AsUnaryExpression(n treesitter.Node) (UnaryExpression, bool) {
+	return UnaryExpression{n}, n.IsNamed() && n.Type() == "unary_expression"
+}
+
+// Operand returns the node in the operand field.
+func (n UnaryExpression) Operand() Expression {
+	return Expression{n.ChildByFieldName("operand")}
+}
+
+// Operator returns the node in the operator field.
+func (n UnaryExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// VarDeclaration wraps nodes of type var_declaration.
+type VarDeclaration struct{ treesitter.Node }
+
+// AsVarDeclaration converts n to VarDeclaration, reporting whether it is of type var_declaration.
+func AsVarDeclaration(n treesitter.Node) (VarDeclaration, bool) {
+	return VarDeclaration{n}, n.IsNamed() && n.Type() == "var_declaration"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n VarDeclaration) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// VarSpec wraps nodes of type var_spec.
+type VarSpec struct{ treesitter.Node }
+
+// AsVarSpec converts n to VarSpec, reporting whether it is of type var_spec.
+func AsVarSpec(n treesitter.Node) (VarSpec, bool) {
+	return VarSpec{n}, n.IsNamed() && n.Type() == "var_spec"
+}
+
+// Name returns the nodes in the name field.
+func (n VarSpec) Name() []Identifier {
+	var nodes []Identifier
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "name" {
+			nodes = append(nodes, Identifier{c})
+		}
+	}
+	return nodes
+}
+
+// TypeField returns the node in the type field.
+func (n VarSpec) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// Value returns the node in the value field.
+func (n VarSpec) Value() ExpressionList {
+	return ExpressionList{n.ChildByFieldName("value")}
+}
+
+// VarSpecList wraps nodes of type var_spec_list.
+type VarSpecList struct{ treesitter.Node }
+
+// AsVarSpecList converts n to VarSpecList, reporting whether it is of type var_spec_list.
+func AsVarSpecList(n treesitter.Node) (VarSpecList, bool) {
+	return VarSpecList{n}, n.IsNamed() && n.Type() == "var_spec_list"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n VarSpecList) Contents() []VarSpec {
+	var nodes []VarSpec
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, VarSpec{c})
+		}
+	}
+	return nodes
+}
+
+// VariadicArgument wraps nodes of type variadic_argument.
+type VariadicArgument struct{ treesitter.Node }
+
+// AsVariadicArgument converts n to VariadicArgument, reporting whether it is of type variadic_argument.
+func AsVariadicArgument(n treesitter.Node) (VariadicArgument, bool) {
+	return VariadicArgument{n}, n.IsNamed() && n.Type() == "variadic_argument"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n VariadicArgument) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// VariadicParameterDeclaration wraps nodes of type variadic_parameter_declaration.
+type VariadicParameterDeclaration struct{ treesitter.Node }
+
+// AsVariadicParameterDeclaration converts n to VariadicParameterDeclaration, reporting whether it is of type variadic_parameter_declaration.
+func AsVariadicParameterDeclaration(n treesitter.Node) (VariadicParameterDeclaration, bool) {
+	return VariadicParameterDeclaration{n}, n.IsNamed() && n.Type() == "variadic_parameter_declaration"
+}
+
+// Name returns the node in the name field.
+func (n VariadicParameterDeclaration) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// TypeField returns the node in the type field.
+func (n VariadicParameterDeclaration) TypeField() treesitter.Node {
+	return n.ChildByFieldName("type")
+}
+
+// BlankIdentifier wraps nodes of type blank_identifier.
+type BlankIdentifier struct{ treesitter.Node }
+
+// AsBlankIdentifier converts n to BlankIdentifier, reporting whether it is of type blank_identifier.
+func AsBlankIdentifier(n treesitter.Node) (BlankIdentifier, bool) {
+	return BlankIdentifier{n}, n.IsNamed() && n.Type() == "blank_identifier"
+}
+
+// Comment wraps nodes of type comment.
+type Comment struct{ treesitter.Node }
+
+// AsComment converts n to Comment, reporting whether it is of type comment.
+func AsComment(n treesitter.Node) (Comment, bool) {
+	return Comment{n}, n.IsNamed() && n.Type() == "comment"
+}
+
+// EscapeSequence wraps nodes of type escape_sequence.
+type EscapeSequence struct{ treesitter.Node }
+
+// AsEscapeSequence converts n to EscapeSequence, reporting whether it is of type escape_sequence.
+func AsEscapeSequence(n treesitter.Node) (EscapeSequence, bool) {
+	return EscapeSequence{n}, n.IsNamed() && n.Type() == "escape_sequence"
+}
+
+// False wraps nodes of type false.
+type False struct{ treesitter.Node }
+
+// AsFalse converts n to False, reporting whether it is of type false.
+func AsFalse(n treesitter.Node) (False, bool) {
+	return False{n}, n.IsNamed() && n.Type() == "false"
+}
+
+// FieldIdentifier wraps nodes of type field_identifier.
+type FieldIdentifier struct{ treesitter.Node }
+
+// AsFieldIdentifier converts n to FieldIdentifier, reporting whether it is of type field_identifier.
+func AsFieldIdentifier(n treesitter.Node) (FieldIdentifier, bool) {
+	return FieldIdentifier{n}, n.IsNamed() && n.Type() == "field_identifier"
+}
+
+// FloatLiteral wraps nodes of type float_literal.
+type FloatLiteral struct{ treesitter.Node }
+
+// AsFloatLiteral converts n to FloatLiteral, reporting whether it is of type float_literal.
+func AsFloatLiteral(n treesitter.Node) (FloatLiteral, bool) {
+	return FloatLiteral{n}, n.IsNamed() && n.Type() == "float_literal"
+}
+
+// Identifier wraps nodes of type identifier.
+type Identifier struct{ treesitter.Node }
+
+// AsIdentifier converts n to Identifier, reporting whether it is of type identifier.
+func AsIdentifier(n treesitter.Node) (Identifier, bool) {
+	return Identifier{n}, n.IsNamed() && n.Type() == "identifier"
+}
+
+// ImaginaryLiteral wraps nodes of type imaginary_literal.
+type ImaginaryLiteral struct{ treesitter.Node }
+
+// AsImaginaryLiteral converts n to ImaginaryLiteral, reporting whether it is of type imaginary_literal.
+func AsImaginaryLiteral(n treesitter.Node) (ImaginaryLiteral, bool) {
+	return ImaginaryLiteral{n}, n.IsNamed() && n.Type() == "imaginary_literal"
+}
+
+// IntLiteral wraps nodes of type int_literal.
+type IntLiteral struct{ treesitter.Node }
+
+// AsIntLiteral converts n to IntLiteral, reporting whether it is of type int_literal.
+func AsIntLiteral(n treesitter.Node) (IntLiteral, bool) {
+	return IntLiteral{n}, n.IsNamed() && n.Type() == "int_literal"
+}
+
+// Iota wraps nodes of type iota.
+type Iota struct{ treesitter.Node }
+
+// AsIota converts n to Iota, reporting whether it is of type iota.
+func AsIota(n treesitter.Node) (Iota, bool) {
+	return Iota{n}, n.IsNamed() && n.Type() == "iota"
+}
+
+// LabelName wraps nodes of type label_name.
+type LabelName struct{ treesitter.Node }
+
+// AsLabelName converts n to LabelName, reporting whether it is of type label_name.
+func AsLabelName(n treesitter.Node) (LabelName, bool) {
+	return LabelName{n}, n.IsNamed() && n.Type() == "label_name"
+}
+
+// Nil wraps nodes of type nil.
+type Nil struct{ treesitter.Node }
+
+// AsNil converts n to Nil, reporting whether it is of type nil.
+func AsNil(n treesitter.Node) (Nil, bool) {
+	return Nil{n}, n.IsNamed() && n.Type() == "nil"
+}
+
+// PackageIdentifier wraps nodes of type package_identifier.
+type PackageIdentifier struct{ treesitter.Node }
+
+// AsPackageIdentifier converts n to PackageIdentifier, reporting whether it is of type package_identifier.
+func AsPackageIdentifier(n treesitter.Node) (PackageIdentifier, bool) {
+	return PackageIdentifier{n}, n.IsNamed() && n.Type() == "package_identifier"
+}
+
+// RawStringLiteral wraps nodes of type raw_string_literal.
+type RawStringLiteral struct{ treesitter.Node }
+
+// AsRawStringLiteral converts n to RawStringLiteral, reporting whether it is of type raw_string_literal.
+func AsRawStringLiteral(n treesitter.Node) (RawStringLiteral, bool) {
+	return RawStringLiteral{n}, n.IsNamed() && n.Type() == "raw_string_literal"
+}
+
+// RuneLiteral wraps nodes of type rune_literal.
+type RuneLiteral struct{ treesitter.Node }
+
+// AsRuneLiteral converts n to RuneLiteral, reporting whether it is of type rune_literal.
+func AsRuneLiteral(n treesitter.Node) (RuneLiteral, bool) {
+	return RuneLiteral{n}, n.IsNamed() && n.Type() == "rune_literal"
+}
+
+// True wraps nodes of type true.
+type True struct{ treesitter.Node }
+
+// AsTrue converts n to True, reporting whether it is of type true.
+func AsTrue(n treesitter.Node) (True, bool) {
+	return True{n}, n.IsNamed() && n.Type() == "true"
+}
+
+// TypeIdentifier wraps nodes of type type_identifier.
+type TypeIdentifier struct{ treesitter.Node }
+
+// AsTypeIdentifier converts n to TypeIdentifier, reporting whether it is of type type_identifier.
+func AsTypeIdentifier(n treesitter.Node) (TypeIdentifier, bool) {
+	return TypeIdentifier{n}, n.IsNamed() && n.Type() == "type_identifier"
+}
@@ -0,0 +1,36 @@
+package golang
+
+import "github.com/boldsoftware/treesitter"
+
+func init() {
+	treesitter.RegisterProfile("go", &treesitter.Profile{
+		Declarations: []string{
+			"function_declaration",
+			"method_declaration",
+			"type_spec",
+			"const_spec",
+			"var_spec",
+		},
+		Containers: []string{
+			"type_declaration",
+			"const_declaration",
+			"var_declaration",
+		},
+		Statements: []string{
+			"expression_statement",
+			"assignment_statement",
+			"short_var_declaration",
+			"inc_statement",
+			"dec_statement",
+			"send_statement",
+			"return_statement",
+			"go_statement",
+			"defer_statement",
+			"if_statement",
+			"for_statement",
+			"expression_switch_statement",
+			"type_switch_statement",
+			"select_statement",
+		},
+	})
+}
@@ -0,0 +1,152 @@
+// Code generated by tsgen. DO NOT EDIT.
+
+package golang
+
+import "github.com/boldsoftware/treesitter"
+
+// Symbols of the named node types, as returned by treesitter.Node.Symbol.
+const (
+	SymIdentifier                   treesitter.Symbol = 1   // identifier
+	SymBlankIdentifier              treesitter.Symbol = 8   // blank_identifier
+	SymRawStringLiteral             treesitter.Symbol = 80  // raw_string_literal
+	SymEscapeSequence               treesitter.Symbol = 84  // escape_sequence
+	SymIntLiteral                   treesitter.Symbol = 85  // int_literal
+	SymFloatLiteral                 treesitter.Symbol = 86  // float_literal
+	SymImaginaryLiteral             treesitter.Symbol = 87  // imaginary_literal
+	SymRuneLiteral                  treesitter.Symbol = 88  // rune_literal
+	SymNil                          treesitter.Symbol = 89  // nil
+	SymTrue                         treesitter.Symbol = 90  // true
+	SymFalse                        treesitter.Symbol = 91  // false
+	SymIota                         treesitter.Symbol = 92  // iota
+	SymComment                      treesitter.Symbol = 93  // comment
+	SymSourceFile                   treesitter.Symbol = 94  // source_file
+	SymPackageClause                treesitter.Symbol = 95  // package_clause
+	SymImportDeclaration            treesitter.Symbol = 96  // import_declaration
+	SymImportSpec                   treesitter.Symbol = 97  // import_spec
+	SymDot                          treesitter.Symbol = 98  // dot
+	SymImportSpecList               treesitter.Symbol = 99  // import_spec_list
+	SymConstDeclaration             treesitter.Symbol = 101 // const_declaration
+	SymConstSpec                    treesitter.Symbol = 102 // const_spec
+	SymVarDeclaration               treesitter.Symbol = 103 // var_declaration
+	SymVarSpec                      treesitter.Symbol = 104 // var_spec
+	SymVarSpecList                  treesitter.Symbol = 105 // var_spec_list
+	SymFunctionDeclaration          treesitter.Symbol = 106 // function_declaration
+	SymMethodDeclaration            treesitter.Symbol = 107 // method_declaration
+	SymTypeParameterList            treesitter.Symbol = 108 // type_parameter_list
+	SymTypeParameterDeclaration     treesitter.Symbol = 109 // type_parameter_declaration
+	SymParameterList                treesitter.Symbol = 110 // parameter_list
+	SymParameterDeclaration         treesitter.Symbol = 111 // parameter_declaration
+	SymVariadicParameterDeclaration treesitter.Symbol = 112 // variadic_parameter_declaration
+	SymTypeAlias                    treesitter.Symbol = 113 // type_alias
+	SymTypeDeclaration              treesitter.Symbol = 114 // type_declaration
+	SymTypeSpec                     treesitter.Symbol = 115 // type_spec
+	SymExpressionList               treesitter.Symbol = 116 // expression_list
+	SymParenthesizedType            treesitter.Symbol = 117 // parenthesized_type
+	SymGenericType                  treesitter.Symbol = 119 // generic_type
+	SymTypeArguments                treesitter.Symbol = 120 // type_arguments
+	SymPointerType                  treesitter.Symbol = 121 // pointer_type
+	SymArrayType                    treesitter.Symbol = 122 // array_type
+	SymImplicitLengthArrayType      treesitter.Symbol = 123 // implicit_length_array_type
+	SymSliceType                    treesitter.Symbol = 124 // slice_type
+	SymStructType                   treesitter.Symbol = 125 // struct_type
+	SymNegatedType                  treesitter.Symbol = 126 // negated_type
+	SymFieldDeclarationList         treesitter.Symbol = 127 // field_declaration_list
+	SymFieldDeclaration             treesitter.Symbol = 128 // field_declaration
+	SymInterfaceType                treesitter.Symbol = 129 // interface_type
+	SymMethodElem                   treesitter.Symbol = 130 // method_elem
+	SymTypeElem                     treesitter.Symbol = 131 // type_elem
+	SymMapType                      treesitter.Symbol = 132 // map_type
+	SymChannelType                  treesitter.Symbol = 133 // channel_type
+	SymFunctionType                 treesitter.Symbol = 134 // function_type
+	SymBlock                        treesitter.Symbol = 135 // block
+	SymEmptyStatement               treesitter.Symbol = 138 // empty_statement
+	SymExpressionStatement          treesitter.Symbol = 140 // expression_statement
+	SymSendStatement                treesitter.Symbol = 141 // send_statement
+	SymReceiveStatement             treesitter.Symbol = 142 // receive_statement
+	SymIncStatement                 treesitter.Symbol = 143 // inc_statement
+	SymDecStatement                 treesitter.Symbol = 144 // dec_statement
+	SymAssignmentStatement          treesitter.Symbol = 145 // assignment_statement
+	SymShortVarDeclaration          treesitter.Symbol = 146 // short_var_declaration
+	SymLabeledStatement             treesitter.Symbol = 147 // labeled_statement
+	SymFallthroughStatement         treesitter.Symbol = 149 // fallthrough_statement
+	SymBreakStatement               treesitter.Symbol = 150 // break_statement
+	SymContinueStatement            treesitter.Symbol = 151 // continue_statement
+	SymGotoStatement                treesitter.Symbol = 152 // goto_statement
+	SymReturnStatement              treesitter.Symbol = 153 // return_statement
+	SymGoStatement                  treesitter.Symbol = 154 // go_statement
+	SymDeferStatement               treesitter.Symbol = 155 // defer_statement
+	SymIfStatement                  treesitter.Symbol = 156 // if_statement
+	SymForStatement                 treesitter.Symbol = 157 // for_statement
+	SymForClause                    treesitter.Symbol = 158 // for_clause
+	SymRangeClause                  treesitter.Symbol = 159 // range_clause
+	SymExpressionSwitchStatement    treesitter.Symbol = 160 // expression_switch_statement
+	SymExpressionCase               treesitter.Symbol = 161 // expression_case
+	SymDefaultCase                  treesitter.Symbol = 162 // default_case
+	SymTypeSwitchStatement          treesitter.Symbol = 163 // type_switch_statement
+	SymTypeCase                     treesitter.Symbol = 165 // type_case
+	SymSelectStatement              treesitter.Symbol = 166 // select_statement
+	SymCommunicationCase            treesitter.Symbol = 167 // communication_case
+	SymParenthesizedExpression      treesitter.Symbol = 169 // parenthesized_expression
+	SymCallExpression               treesitter.Symbol = 170 // call_expression
+	SymVariadicArgument             treesitter.Symbol = 171 // variadic_argument
+	SymArgumentList                 treesitter.Symbol = 173 // argument_list
+	SymSelectorExpression           treesitter.Symbol = 174 // selector_expression
+	SymIndexExpression              treesitter.Symbol = 175 // index_expression
+	SymSliceExpression              treesitter.Symbol = 176 // slice_expression
+	SymTypeAssertionExpression      treesitter.Symbol = 177 // type_assertion_expression
+	SymTypeConversionExpression     treesitter.Symbol = 178 // type_conversion_expression
+	SymTypeInstantiationExpression  treesitter.Symbol = 179 // type_instantiation_expression
+	SymCompositeLiteral             treesitter.Symbol = 180 // composite_literal
+	SymLiteralValue                 treesitter.Symbol = 181 // literal_value
+	SymLiteralElement               treesitter.Symbol = 182 // literal_element
+	SymKeyedElement                 treesitter.Symbol = 183 // keyed_element
+	SymFuncLiteral                  treesitter.Symbol = 184 // func_literal
+	SymUnaryExpression              treesitter.Symbol = 185 // unary_expression
+	SymBinaryExpression             treesitter.Symbol = 186 // binary_expression
+	SymQualifiedType                treesitter.Symbol = 187 // qualified_type
+	SymInterpretedStringLiteral     treesitter.Symbol = 188 // interpreted_string_literal
+	SymFieldIdentifier              treesitter.Symbol = 212 // field_identifier
+	SymLabelName                    treesitter.Symbol = 213 // label_name
+	SymPackageIdentifier            treesitter.Symbol = 214 // package_identifier
+	SymTypeConstraint               treesitter.Symbol = 215 // type_constraint
+	SymTypeIdentifier               treesitter.Symbol = 216 // type_identifier
+)
+
+// Fields, as returned by treesitter.Node.FieldIDForChild.
+const (
+	FieldAlias          treesitter.FieldID = 1  // alias
+	FieldAlternative    treesitter.FieldID = 2  // alternative
+	FieldArguments      treesitter.FieldID = 3  // arguments
+	FieldBody           treesitter.FieldID = 4  // body
+	FieldCapacity       treesitter.FieldID = 5  // capacity
+	FieldChannel        treesitter.FieldID = 6  // channel
+	FieldCommunication  treesitter.FieldID = 7  // communication
+	FieldCondition      treesitter.FieldID = 8  // condition
+	FieldConsequence    treesitter.FieldID = 9  // consequence
+	FieldElement        treesitter.FieldID = 10 // element
+	FieldEnd            treesitter.FieldID = 11 // end
+	FieldField          treesitter.FieldID = 12 // field
+	FieldFunction       treesitter.FieldID = 13 // function
+	FieldIndex          treesitter.FieldID = 14 // index
+	FieldInitializer    treesitter.FieldID = 15 // initializer
+	FieldKey            treesitter.FieldID = 16 // key
+	FieldLabel          treesitter.FieldID = 17 // label
+	FieldLeft           treesitter.FieldID = 18 // left
+	FieldLength         treesitter.FieldID = 19 // length
+	FieldName           treesitter.FieldID = 20 // name
+	FieldOperand        treesitter.FieldID = 21 // operand
+	FieldOperator       treesitter.FieldID = 22 // operator
+	FieldPackage        treesitter.FieldID = 23 // package
+	FieldParameters     treesitter.FieldID = 24 // parameters
+	FieldPath           treesitter.FieldID = 25 // path
+	FieldReceiver       treesitter.FieldID = 26 // receiver
+	FieldResult         treesitter.FieldID = 27 // result
+	FieldRight          treesitter.FieldID = 28 // right
+	FieldStart          treesitter.FieldID = 29 // start
+	FieldTag            treesitter.FieldID = 30 // tag
+	FieldType           treesitter.FieldID = 31 // type
+	FieldTypeArguments  treesitter.FieldID = 32 // type_arguments
+	FieldTypeParameters treesitter.FieldID = 33 // type_parameters
+	FieldUpdate         treesitter.FieldID = 34 // update
+	FieldValue          treesitter.FieldID = 35 // value
+)
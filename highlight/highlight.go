@@ -0,0 +1,308 @@
+// Package highlight computes syntax highlighting from highlights queries, such as
+// the ones bundled in the queries package, and keeps it up to date as the source is edited.
+//
+//	h, err := highlight.ForLanguage("go")
+//	res := h.Highlight(tree, src)
+//
+//	// after each edit of the source
+//	tree.Edit(edit)
+//	res.Edit(edit)
+//	newTree, err := parser.Parse(ctx, tree, newSrc)
+//	h.Update(res, tree, newTree, newSrc)
+package highlight
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/boldsoftware/treesitter"
+	"github.com/boldsoftware/treesitter/queries"
+)
+
+// Highlight is a span of source highlighted as a capture of a highlights query,
+// such as "keyword" or "function.builtin".
+type Highlight struct {
+	treesitter.Range
+	Name string
+}
+
+// Highlighter computes the highlights of syntax trees with a highlights query.
+//
+// Highlights don't overlap: where the captures of a node and of its descendants nest,
+// such as an escape sequence in a string, the innermost one applies. Where a node is
+// captured by several patterns, the first one applies.
+type Highlighter struct {
+	q *treesitter.Query
+}
+
+// New returns a highlighter using the highlights query q.
+func New(q *treesitter.Query) *Highlighter {
+	return &Highlighter{q: q}
+}
+
+// ForLanguage returns a highlighter using the bundled highlights query of language.
+func ForLanguage(language string) (*Highlighter, error) {
+	q, err := queries.Highlights(language)
+	if err != nil {
+		return nil, err
+	}
+	return New(q), nil
+}
+
+// Result holds the highlights of a tree, in order.
+type Result struct {
+	Highlights []Highlight
+
+	// the ranges edited since the highlights were computed, in the coordinates of the new source
+	edited []treesitter.Range
+}
+
+// Highlight returns the highlights of tree, parsed from src.
+func (h *Highlighter) Highlight(tree *treesitter.Tree, src []byte) *Result {
+	root := tree.RootNode()
+	return &Result{Highlights: h.highlightRange(root, src, root.Range())}
+}
+
+// Edit moves the highlights to keep them in sync with source code that has been edited,
+// as Tree.Edit does for a tree. The highlights of the edited text are only recomputed by Update.
+func (r *Result) Edit(e treesitter.EditInput) {
+	hs := r.Highlights[:0]
+	for _, h := range r.Highlights {
+		if h.Range = editRange(h.Range, e); h.StartByte < h.EndByte {
+			hs = append(hs, h)
+		}
+	}
+	r.Highlights = hs
+
+	for i, edited := range r.edited {
+		r.edited[i] = editRange(edited, e)
+	}
+	r.edited = append(r.edited, treesitter.Range{
+		StartPoint: e.StartPoint,
+		EndPoint:   e.NewEndPoint,
+		StartByte:  e.StartIndex,
+		EndByte:    e.NewEndIndex,
+	})
+}
+
+// Update recomputes the highlights of r for newTree, the tree parsed from src with oldTree,
+// which was edited along with r, as the old tree. Only the highlights of the text that was
+// edited or whose syntactic structure changed are recomputed, and spliced into the others.
+// It returns the ranges of src whose highlights were recomputed.
+func (h *Highlighter) Update(r *Result, oldTree, newTree *treesitter.Tree, src []byte) []treesitter.Range {
+	root := newTree.RootNode()
+	regions := append(oldTree.ChangedRanges(newTree), r.edited...)
+	r.edited = nil
+
+	// a highlight can depend on the text of the whole node it captures,
+	// as with #match? predicates, so recompute those of every node touched
+	for i, region := range regions {
+		from, to := region.StartByte, region.EndByte
+		if from == to {
+			// text was deleted: the nodes on either side may have changed
+			from, to = max(from-1, 0), to+1
+		}
+		for _, c := range h.captures(root, src, from, to) {
+			if c.StartByte < to && c.EndByte > from {
+				regions[i] = union(regions[i], c.Range)
+			}
+		}
+	}
+	regions = merge(regions)
+
+	var hs []Highlight
+	prev := r.Highlights
+	for _, region := range regions {
+		for len(prev) > 0 && prev[0].StartByte < region.StartByte {
+			hs = appendHighlight(hs, prev[0].Name, start(prev[0].Range), minPosition(end(prev[0].Range), start(region)))
+			if prev[0].EndByte <= region.EndByte {
+				prev = prev[1:]
+				continue
+			}
+			// the rest of the highlight is after the region
+			prev[0].Range = rangeOf(end(region), end(prev[0].Range))
+			break
+		}
+		for len(prev) > 0 && prev[0].StartByte < region.EndByte {
+			if prev[0].EndByte > region.EndByte {
+				prev[0].Range = rangeOf(end(region), end(prev[0].Range))
+				break
+			}
+			prev = prev[1:]
+		}
+		for _, hl := range h.highlightRange(root, src, region) {
+			hs = appendHighlight(hs, hl.Name, start(hl.Range), end(hl.Range))
+		}
+	}
+	for _, hl := range prev {
+		hs = appendHighlight(hs, hl.Name, start(hl.Range), end(hl.Range))
+	}
+	r.Highlights = hs
+	return regions
+}
+
+// capture is a node captured by a highlights query.
+type capture struct {
+	treesitter.Range
+	name    string
+	pattern uint16
+}
+
+// captures returns the captures of the nodes intersecting the given range of bytes,
+// ordered by start and with enclosing nodes first. Where several patterns capture
+// the same range, only the capture of the first one is kept.
+func (h *Highlighter) captures(root treesitter.Node, src []byte, startByte, endByte int) []capture {
+	qc := treesitter.DefaultQueryCursorPool.Get()
+	defer treesitter.DefaultQueryCursorPool.Put(qc)
+	qc.SetByteRange(startByte, endByte)
+
+	var captures []capture
+	seen := make(map[[2]int]int)
+	for m, i := range qc.Captures(h.q, root, src) {
+		c := m.Captures[i]
+		if c.Name == "" || c.Name[0] == '_' {
+			// private captures used by predicates
+			continue
+		}
+		key := [2]int{c.Node.StartByte(), c.Node.EndByte()}
+		if j, ok := seen[key]; ok {
+			if m.PatternIndex < captures[j].pattern {
+				captures[j].name, captures[j].pattern = c.Name, m.PatternIndex
+			}
+			continue
+		}
+		seen[key] = len(captures)
+		captures = append(captures, capture{Range: c.Node.Range(), name: c.Name, pattern: m.PatternIndex})
+	}
+	slices.SortStableFunc(captures, func(a, b capture) int {
+		return cmp.Or(cmp.Compare(a.StartByte, b.StartByte), cmp.Compare(b.EndByte, a.EndByte))
+	})
+	return captures
+}
+
+// highlightRange returns the highlights of the part of the tree within region.
+func (h *Highlighter) highlightRange(root treesitter.Node, src []byte, region treesitter.Range) []Highlight {
+	var hs []Highlight
+	emit := func(name string, from, to position) {
+		if from.byte < region.StartByte {
+			from = start(region)
+		}
+		hs = appendHighlight(hs, name, from, minPosition(to, end(region)))
+	}
+
+	// the captures of nodes nest: highlight the parts of each capture
+	// not covered by the captures nested in it
+	var stack []capture
+	var pos position
+	for _, c := range h.captures(root, src, region.StartByte, region.EndByte) {
+		for len(stack) > 0 && stack[len(stack)-1].EndByte <= c.StartByte {
+			top := stack[len(stack)-1]
+			emit(top.name, pos, end(top.Range))
+			pos = end(top.Range)
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 {
+			top := stack[len(stack)-1]
+			emit(top.name, pos, start(c.Range))
+			if c.EndByte > top.EndByte {
+				c.Range = rangeOf(start(c.Range), end(top.Range))
+			}
+		}
+		pos = start(c.Range)
+		stack = append(stack, c)
+	}
+	for _, c := range slices.Backward(stack) {
+		emit(c.name, pos, end(c.Range))
+		pos = end(c.Range)
+	}
+	return hs
+}
+
+// appendHighlight appends the highlight of the given span to hs, unless it is empty,
+// extending the last highlight instead if the span continues it.
+func appendHighlight(hs []Highlight, name string, from, to position) []Highlight {
+	if from.byte >= to.byte {
+		return hs
+	}
+	if n := len(hs); n > 0 && hs[n-1].Name == name && hs[n-1].EndByte == from.byte {
+		hs[n-1].EndByte, hs[n-1].EndPoint = to.byte, to.point
+		return hs
+	}
+	return append(hs, Highlight{Range: rangeOf(from, to), Name: name})
+}
+
+// position is a position in the source.
+type position struct {
+	byte  int
+	point treesitter.Point
+}
+
+func start(r treesitter.Range) position { return position{r.StartByte, r.StartPoint} }
+
+func end(r treesitter.Range) position { return position{r.EndByte, r.EndPoint} }
+
+func rangeOf(from, to position) treesitter.Range {
+	return treesitter.Range{StartPoint: from.point, EndPoint: to.point, StartByte: from.byte, EndByte: to.byte}
+}
+
+func minPosition(a, b position) position {
+	if b.byte < a.byte {
+		return b
+	}
+	return a
+}
+
+func union(a, b treesitter.Range) treesitter.Range {
+	from, to := start(a), end(a)
+	if b.StartByte < from.byte {
+		from = start(b)
+	}
+	if b.EndByte > to.byte {
+		to = end(b)
+	}
+	return rangeOf(from, to)
+}
+
+// merge sorts ranges and merges those that overlap or touch.
+func merge(ranges []treesitter.Range) []treesitter.Range {
+	slices.SortFunc(ranges, func(a, b treesitter.Range) int { return cmp.Compare(a.StartByte, b.StartByte) })
+	var merged []treesitter.Range
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.StartByte <= merged[n-1].EndByte {
+			merged[n-1] = union(merged[n-1], r)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// editRange moves r to keep it in sync with the edit e. The parts of r in the replaced text
+// are moved to the replacement.
+func editRange(r treesitter.Range, e treesitter.EditInput) treesitter.Range {
+	from, to := start(r), end(r)
+	switch {
+	case from.byte >= e.OldEndIndex:
+		from = editedPosition(from, e)
+	case from.byte > e.StartIndex:
+		from = position{e.StartIndex, e.StartPoint}
+	}
+	switch {
+	case to.byte >= e.OldEndIndex:
+		to = editedPosition(to, e)
+	case to.byte > e.StartIndex:
+		to = position{e.NewEndIndex, e.NewEndPoint}
+	}
+	return rangeOf(from, to)
+}
+
+// editedPosition returns where p, which is after the text replaced by e, is after the edit.
+func editedPosition(p position, e treesitter.EditInput) position {
+	p.byte += e.NewEndIndex - e.OldEndIndex
+	if p.point.Row == e.OldEndPoint.Row {
+		p.point = treesitter.Point{Row: e.NewEndPoint.Row, Column: p.point.Column - e.OldEndPoint.Column + e.NewEndPoint.Column}
+	} else {
+		p.point.Row += e.NewEndPoint.Row - e.OldEndPoint.Row
+	}
+	return p
+}
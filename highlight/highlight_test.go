@@ -0,0 +1,193 @@
+package highlight_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/boldsoftware/treesitter"
+	"github.com/boldsoftware/treesitter/highlight"
+	_ "github.com/boldsoftware/treesitter/langs/all"
+	"github.com/stretchr/testify/assert"
+)
+
+const src = `package main
+
+import "fmt"
+
+// run says hi
+func run() {
+	println("hi\n")
+	fmt.Println(len("hello"))
+}
+`
+
+func parse(t *testing.T, old *treesitter.Tree, src []byte) *treesitter.Tree {
+	t.Helper()
+	tree, err := treesitter.NewParser("go").Parse(context.Background(), old, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tree
+}
+
+func texts(hs []highlight.Highlight, src []byte) [][2]string {
+	var texts [][2]string
+	for _, h := range hs {
+		texts = append(texts, [2]string{string(src[h.StartByte:h.EndByte]), h.Name})
+	}
+	return texts
+}
+
+func TestHighlight(t *testing.T) {
+	assert := assert.New(t)
+
+	h, err := highlight.ForLanguage("go")
+	assert.NoError(err)
+	res := h.Highlight(parse(t, nil, []byte(src)), []byte(src))
+	assert.Equal([][2]string{
+		{"package", "keyword"},
+		{"main", "namespace"},
+		{"import", "keyword"},
+		{`"fmt"`, "string"},
+		{"// run says hi", "comment"},
+		{"func", "keyword"},
+		{"run", "function"},
+		{"println", "function.builtin"},
+		// the escape sequence is nested in the string
+		{`"hi`, "string"},
+		{`\n`, "escape"},
+		{`"`, "string"},
+		{"fmt", "variable"},
+		{"Println", "function.method"},
+		{"len", "function.builtin"},
+		{`"hello"`, "string"},
+	}, texts(res.Highlights, []byte(src)))
+
+	for i, hl := range res.Highlights {
+		assert.Less(hl.StartByte, hl.EndByte)
+		if i > 0 {
+			assert.LessOrEqual(res.Highlights[i-1].EndByte, hl.StartByte)
+		}
+	}
+	assert.Equal(treesitter.Point{Row: 6, Column: 1}, res.Highlights[7].StartPoint)
+
+	_, err = highlight.ForLanguage("cobol")
+	assert.Error(err)
+}
+
+// edit replaces the bytes of src from start to end with text.
+func edit(src []byte, start, end int, text string) ([]byte, treesitter.EditInput) {
+	newSrc := append(append(bytes.Clone(src[:start]), text...), src[end:]...)
+	return newSrc, treesitter.EditInput{
+		StartIndex:  start,
+		OldEndIndex: end,
+		NewEndIndex: start + len(text),
+		StartPoint:  point(src, start),
+		OldEndPoint: point(src, end),
+		NewEndPoint: point(newSrc, start+len(text)),
+	}
+}
+
+func point(src []byte, offset int) treesitter.Point {
+	line := bytes.Count(src[:offset], []byte("\n"))
+	return treesitter.Point{Row: line, Column: offset - (bytes.LastIndexByte(src[:offset], '\n') + 1)}
+}
+
+func TestUpdate(t *testing.T) {
+	h, err := highlight.ForLanguage("go")
+	assert.NoError(t, err)
+
+	at := func(s string) int { return bytes.Index([]byte(src), []byte(s)) }
+	for _, tc := range []struct {
+		name       string
+		start, end int
+		text       string
+	}{
+		{"rename builtin", at("println"), at("println") + len("println"), "printhi"},
+		{"append to builtin", at("len(") + 3, at("len(") + 3, "gth"},
+		{"insert line", at("\tprintln"), at("\tprintln"), "\tx := 1\n"},
+		{"delete call", at("\tfmt"), at("}\n"), ""},
+		{"open comment", at("func"), at("func"), "/* "},
+		{"close string", at(`"hi`) + 3, at(`"hi`) + 3, `", "`},
+		{"replace all", 0, len(src), "package other\n"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			oldSrc := []byte(src)
+			tree := parse(t, nil, oldSrc)
+			res := h.Highlight(tree, oldSrc)
+
+			newSrc, e := edit(oldSrc, tc.start, tc.end, tc.text)
+			tree.Edit(e)
+			res.Edit(e)
+			newTree := parse(t, tree, newSrc)
+			ranges := h.Update(res, tree, newTree, newSrc)
+			assert.NotEmpty(ranges)
+
+			want := h.Highlight(parse(t, nil, newSrc), newSrc)
+			assert.Equal(texts(want.Highlights, newSrc), texts(res.Highlights, newSrc))
+			assert.Equal(want.Highlights, res.Highlights)
+		})
+	}
+}
+
+func TestUpdateSeveralEdits(t *testing.T) {
+	assert := assert.New(t)
+	h, err := highlight.ForLanguage("go")
+	assert.NoError(err)
+
+	oldSrc := []byte(src)
+	tree := parse(t, nil, oldSrc)
+	res := h.Highlight(tree, oldSrc)
+
+	// edits are applied one after the other, and the highlights updated once
+	newSrc := oldSrc
+	for _, text := range []string{"x", "(", "y)", " + len(z"} {
+		var e treesitter.EditInput
+		at := bytes.Index(newSrc, []byte(`"hello"`))
+		newSrc, e = edit(newSrc, at, at, text)
+		tree.Edit(e)
+		res.Edit(e)
+	}
+	newTree := parse(t, tree, newSrc)
+	h.Update(res, tree, newTree, newSrc)
+
+	want := h.Highlight(parse(t, nil, newSrc), newSrc)
+	assert.Equal(texts(want.Highlights, newSrc), texts(res.Highlights, newSrc))
+	assert.Equal(want.Highlights, res.Highlights)
+}
+
+func BenchmarkUpdate(b *testing.B) {
+	h, err := highlight.ForLanguage("go")
+	if err != nil {
+		b.Fatal(err)
+	}
+	src := []byte("package main\n" + strings.Repeat("\nfunc run() {\n\tprintln(\"hi\")\n}\n", 1000))
+	p := treesitter.NewParser("go")
+	tree, err := p.Parse(context.Background(), nil, src)
+	if err != nil {
+		b.Fatal(err)
+	}
+	res := h.Highlight(tree, src)
+
+	at := len(src) / 2
+	for i := 0; i < b.N; i++ {
+		// insert a character, then delete it
+		text, end := "x", at
+		if i%2 == 1 {
+			text, end = "", at+1
+		}
+		newSrc, e := edit(src, at, end, text)
+		tree.Edit(e)
+		res.Edit(e)
+		newTree, err := p.Parse(context.Background(), tree, newSrc)
+		if err != nil {
+			b.Fatal(err)
+		}
+		h.Update(res, tree, newTree, newSrc)
+		src, tree = newSrc, newTree
+	}
+}
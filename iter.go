@@ -1,6 +1,10 @@
 package treesitter
 
-import "io"
+import (
+	"io"
+	"iter"
+	"slices"
+)
 
 type IterMode int
 
@@ -77,3 +81,120 @@ func (iter *Iterator) ForEach(fn func(Node) error) error {
 		}
 	}
 }
+
+// PreOrder returns an iterator over n and all its descendants, visiting each node before its children.
+func (n Node) PreOrder() iter.Seq[Node] {
+	return func(yield func(Node) bool) {
+		c := NewTreeCursor(n)
+		defer c.Close()
+		for {
+			if !yield(c.CurrentNode()) {
+				return
+			}
+			if c.GoToFirstChild() {
+				continue
+			}
+			for !c.GoToNextSibling() {
+				if !c.GoToParent() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// PostOrder returns an iterator over n and all its descendants, visiting each node after its children.
+func (n Node) PostOrder() iter.Seq[Node] {
+	return func(yield func(Node) bool) {
+		c := NewTreeCursor(n)
+		defer c.Close()
+		for {
+			for c.GoToFirstChild() {
+			}
+			for {
+				if !yield(c.CurrentNode()) {
+					return
+				}
+				if c.GoToNextSibling() {
+					break
+				}
+				if !c.GoToParent() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Leaves returns an iterator over the descendants of n that have no children, in source order.
+// If n has no children, n itself is the only leaf.
+func (n Node) Leaves() iter.Seq[Node] {
+	return func(yield func(Node) bool) {
+		c := NewTreeCursor(n)
+		defer c.Close()
+		for {
+			if c.GoToFirstChild() {
+				continue
+			}
+			if !yield(c.CurrentNode()) {
+				return
+			}
+			for !c.GoToNextSibling() {
+				if !c.GoToParent() {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Visitor is called by Walk when entering and exiting nodes.
+type Visitor interface {
+	// Enter is called before visiting the children of n.
+	// If it returns false, the children are skipped.
+	Enter(n Node) bool
+	// Exit is called after visiting the children of n, even if they were skipped.
+	Exit(n Node)
+}
+
+// Walk traverses n and all its descendants depth-first with a TreeCursor,
+// calling v.Enter and v.Exit around the visit of each node's children.
+func Walk(n Node, v Visitor) {
+	c := NewTreeCursor(n)
+	defer c.Close()
+	for {
+		node := c.CurrentNode()
+		if v.Enter(node) && c.GoToFirstChild() {
+			continue
+		}
+		v.Exit(node)
+		for !c.GoToNextSibling() {
+			if !c.GoToParent() {
+				return
+			}
+			v.Exit(c.CurrentNode())
+		}
+	}
+}
+
+// Ancestors returns an iterator over the parents of n, from its immediate parent up to the root.
+func (n Node) Ancestors() iter.Seq[Node] {
+	return func(yield func(Node) bool) {
+		for p := n.Parent(); !p.IsNull(); p = p.Parent() {
+			if !yield(p) {
+				return
+			}
+		}
+	}
+}
+
+// AncestorOfType returns the closest ancestor of n whose type is one of types,
+// or a null Node if there is none.
+func (n Node) AncestorOfType(types ...string) Node {
+	for p := range n.Ancestors() {
+		if slices.Contains(types, p.Type()) {
+			return p
+		}
+	}
+	return Node{}
+}
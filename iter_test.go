@@ -0,0 +1,133 @@
+package treesitter
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func nodeTypes(seq iter.Seq[Node]) []string {
+	var types []string
+	for n := range seq {
+		types = append(types, n.Type())
+	}
+	return types
+}
+
+func TestTraversalIterators(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+
+	assert.Equal([]string{"expression", "sum", "expression", "number", "+", "expression", "number"}, nodeTypes(root.PreOrder()))
+	assert.Equal([]string{"number", "expression", "+", "number", "expression", "sum", "expression"}, nodeTypes(root.PostOrder()))
+	assert.Equal([]string{"number", "+", "number"}, nodeTypes(root.Leaves()))
+
+	// iteration is limited to the subtree
+	right := root.Child(0).ChildByFieldName("right")
+	assert.Equal([]string{"expression", "number"}, nodeTypes(right.PreOrder()))
+	assert.Equal([]string{"number", "expression"}, nodeTypes(right.PostOrder()))
+	plus := root.Child(0).Child(1)
+	assert.Equal([]string{"+"}, nodeTypes(plus.Leaves()))
+
+	// early exit
+	var count int
+	for range root.PreOrder() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	assert.Equal(2, count)
+}
+
+func TestAncestors(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+	number := root.Child(0).ChildByFieldName("right").Child(0)
+
+	assert.Equal([]string{"expression", "sum", "expression"}, nodeTypes(number.Ancestors()))
+	assert.Empty(nodeTypes(root.Ancestors()))
+
+	assert.True(number.AncestorOfType("sum").Equal(root.Child(0)))
+	assert.True(number.AncestorOfType("comment", "expression").Equal(root.Child(0).ChildByFieldName("right")))
+	assert.True(number.AncestorOfType("comment").IsNull())
+}
+
+type recordingVisitor struct {
+	events []string
+	skip   string
+}
+
+func (v *recordingVisitor) Enter(n Node) bool {
+	v.events = append(v.events, "+"+n.Type())
+	return n.Type() != v.skip
+}
+
+func (v *recordingVisitor) Exit(n Node) {
+	v.events = append(v.events, "-"+n.Type())
+}
+
+func TestWalk(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+
+	v := &recordingVisitor{}
+	Walk(root.Child(0), v)
+	assert.Equal([]string{
+		"+sum",
+		"+expression", "+number", "-number", "-expression",
+		"++", "-+",
+		"+expression", "+number", "-number", "-expression",
+		"-sum",
+	}, v.events)
+
+	v = &recordingVisitor{skip: "expression"}
+	Walk(root, v)
+	assert.Equal([]string{"+expression", "-expression"}, v.events)
+
+	v = &recordingVisitor{skip: "sum"}
+	Walk(root, v)
+	assert.Equal([]string{"+expression", "+sum", "-sum", "-expression"}, v.events)
+
+	v = &recordingVisitor{}
+	Walk(root.Child(0).Child(1), v)
+	assert.Equal([]string{"++", "-+"}, v.events)
+}
+
+func TestAllNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	small, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+	assert.Equal(nodeTypes(small.RootNode().PreOrder()), nodeTypes(small.AllNodes()))
+
+	c := small.Cursor()
+	assert.True(c.CurrentNode().Equal(small.RootNode()))
+
+	large, err := parser.Parse(context.Background(), nil, []byte(strings.Repeat("1 + ", 100)+"1"))
+	assert.NoError(err)
+
+	mallocs := func(tree *Tree) uint64 {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		n := 0
+		for range tree.AllNodes() {
+			n++
+		}
+		runtime.ReadMemStats(&after)
+		return after.Mallocs - before.Mallocs
+	}
+	// the allocations don't depend on the number of nodes
+	assert.LessOrEqual(mallocs(large), mallocs(small)+2)
+}
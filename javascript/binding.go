@@ -1,15 +1,30 @@
 package javascript
 
+//go:generate go run ../cmd/tsgen -o nodes.go node-types.json
+//go:generate go run ../cmd/tsgen -consts -language javascript -o symbols.go
+
 //#include "parser.h"
 //TSLanguage *tree_sitter_javascript();
 import "C"
 import (
+	_ "embed"
 	"unsafe"
 
 	"github.com/boldsoftware/treesitter"
 )
 
+var language = treesitter.NewLanguage(unsafe.Pointer(C.tree_sitter_javascript()))
+
+//go:embed node-types.json
+var nodeTypes []byte
+
 func init() {
-	ptr := unsafe.Pointer(C.tree_sitter_javascript())
-	treesitter.RegisterLanguage("javascript", treesitter.NewLanguage(ptr))
+	if err := language.SetNodeTypes(nodeTypes); err != nil {
+		panic(err)
+	}
+	treesitter.RegisterLanguage("javascript", language)
+	treesitter.RegisterAlias("js", "javascript")
 }
+
+// GetLanguage returns the JavaScript language, which is also registered as "javascript".
+func GetLanguage() *treesitter.Language { return language }
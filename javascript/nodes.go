@@ -0,0 +1,2105 @@
+// Code generated by tsgen. DO NOT EDIT.
+
+package javascript
+
+import "github.com/boldsoftware/treesitter"
+
+// Declaration wraps nodes of any of the subtypes of declaration.
+type Declaration struct{ treesitter.Node }
+
+// AsDeclaration converts n to Declaration, reporting whether it is of a subtype of declaration.
+func AsDeclaration(n treesitter.Node) (Declaration, bool) {
+	switch n.Type() {
+	case "class_declaration", "function_declaration", "generator_function_declaration", "lexical_declaration", "variable_declaration":
+		return Declaration{n}, n.IsNamed()
+	}
+	return Declaration{n}, false
+}
+
+// Expression wraps nodes of any of the subtypes of expression.
+type Expression struct{ treesitter.Node }
+
+// AsExpression converts n to Expression, reporting whether it is of a subtype of expression.
+func AsExpression(n treesitter.Node) (Expression, bool) {
+	switch n.Type() {
+	case "assignment_expression", "augmented_assignment_expression", "await_expression", "binary_expression", "glimmer_template", "jsx_element", "jsx_self_closing_element", "new_expression", "array", "arrow_function", "call_expression", "class", "false", "function_expression", "generator_function", "identifier", "member_expression", "meta_property", "null", "number", "object", "parenthesized_expression", "regex", "string", "subscript_expression", "super", "template_string", "this", "true", "undefined", "ternary_expression", "unary_expression", "update_expression", "yield_expression":
+		return Expression{n}, n.IsNamed()
+	}
+	return Expression{n}, false
+}
+
+// Pattern wraps nodes of any of the subtypes of pattern.
+type Pattern struct{ treesitter.Node }
+
+// AsPattern converts n to Pattern, reporting whether it is of a subtype of pattern.
+func AsPattern(n treesitter.Node) (Pattern, bool) {
+	switch n.Type() {
+	case "array_pattern", "identifier", "member_expression", "object_pattern", "rest_pattern", "subscript_expression", "undefined":
+		return Pattern{n}, n.IsNamed()
+	}
+	return Pattern{n}, false
+}
+
+// PrimaryExpression wraps nodes of any of the subtypes of primary_expression.
+type PrimaryExpression struct{ treesitter.Node }
+
+// AsPrimaryExpression converts n to PrimaryExpression, reporting whether it is of a subtype of primary_expression.
+func AsPrimaryExpression(n treesitter.Node) (PrimaryExpression, bool) {
+	switch n.Type() {
+	case "array", "arrow_function", "call_expression", "class", "false", "function_expression", "generator_function", "identifier", "member_expression", "meta_property", "null", "number", "object", "parenthesized_expression", "regex", "string", "subscript_expression", "super", "template_string", "this", "true", "undefined":
+		return PrimaryExpression{n}, n.IsNamed()
+	}
+	return PrimaryExpression{n}, false
+}
+
+// Statement wraps nodes of any of the subtypes of statement.
+type Statement struct{ treesitter.Node }
+
+// AsStatement converts n to Statement, reporting whether it is of a subtype of statement.
+func AsStatement(n treesitter.Node) (Statement, bool) {
+	switch n.Type() {
+	case "break_statement", "continue_statement", "debugger_statement", "class_declaration", "function_declaration", "generator_function_declaration", "lexical_declaration", "variable_declaration", "do_statement", "empty_statement", "export_statement", "expression_statement", "for_in_statement", "for_statement", "if_statement", "import_statement", "labeled_statement", "return_statement", "statement_block", "switch_statement", "throw_statement", "try_statement", "while_statement", "with_statement":
+		return Statement{n}, n.IsNamed()
+	}
+	return Statement{n}, false
+}
+
+// Arguments wraps nodes of type arguments.
+type Arguments struct{ treesitter.Node }
+
+// AsArguments converts n to Arguments, reporting whether it is of type arguments.
+func AsArguments(n treesitter.Node) (Arguments, bool) {
+	return Arguments{n}, n.IsNamed() && n.Type() == "arguments"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n Arguments) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// Array wraps nodes of type array.
+type Array struct{ treesitter.Node }
+
+// AsArray converts n to Array, reporting whether it is of type array.
+func AsArray(n treesitter.Node) (Array, bool) {
+	return Array{n}, n.IsNamed() && n.Type() == "array"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n Array) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ArrayPattern wraps nodes of type array_pattern.
+type ArrayPattern struct{ treesitter.Node }
+
+// AsArrayPattern converts n to ArrayPattern, reporting whether it is of type array_pattern.
+func AsArrayPattern(n treesitter.Node) (ArrayPattern, bool) {
+	return ArrayPattern{n}, n.IsNamed() && n.Type() == "array_pattern"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ArrayPattern) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ArrowFunction wraps nodes of type arrow_function.
+type ArrowFunction struct{ treesitter.Node }
+
+// AsArrowFunction converts n to ArrowFunction, reporting whether it is of type arrow_function.
+func AsArrowFunction(n treesitter.Node) (ArrowFunction, bool) {
+	return ArrowFunction{n}, n.IsNamed() && n.Type() == "arrow_function"
+}
+
+// Body returns the node in the body field.
+func (n ArrowFunction) Body() treesitter.Node {
+	return n.ChildByFieldName("body")
+}
+
+// Parameter returns the node in the parameter field.
+func (n ArrowFunction) Parameter() Identifier {
+	return Identifier{n.ChildByFieldName("parameter")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n ArrowFunction) Parameters() FormalParameters {
+	return FormalParameters{n.ChildByFieldName("parameters")}
+}
+
+// AssignmentExpression wraps nodes of type assignment_expression.
+type AssignmentExpression struct{ treesitter.Node }
+
+// AsAssignmentExpression converts n to AssignmentExpression, reporting whether it is of type assignment_expression.
+func AsAssignmentExpression(n treesitter.Node) (AssignmentExpression, bool) {
+	return AssignmentExpression{n}, n.IsNamed() && n.Type() == "assignment_expression"
+}
+
+// Left returns the node in the left field.
+func (n AssignmentExpression) Left() treesitter.Node {
+	return n.ChildByFieldName("left")
+}
+
+// Right returns the node in the right field.
+func (n AssignmentExpression) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// AssignmentPattern wraps nodes of type assignment_pattern.
+type AssignmentPattern struct{ treesitter.Node }
+
+// AsAssignmentPattern converts n to AssignmentPattern, reporting whether it is of type assignment_pattern.
+func AsAssignmentPattern(n treesitter.Node) (AssignmentPattern, bool) {
+	return AssignmentPattern{n}, n.IsNamed() && n.Type() == "assignment_pattern"
+}
+
+// Left returns the node in the left field.
+func (n AssignmentPattern) Left() Pattern {
+	return Pattern{n.ChildByFieldName("left")}
+}
+
+// Right returns the node in the right field.
+func (n AssignmentPattern) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// AugmentedAssignmentExpression wraps nodes of type augmented_assignment_expression.
+type AugmentedAssignmentExpression struct{ treesitter.Node }
+
+// AsAugmentedAssignmentExpression converts n to AugmentedAssignmentExpression, reporting whether it is of type augmented_assignment_expression.
+func AsAugmentedAssignmentExpression(n treesitter.Node) (AugmentedAssignmentExpression, bool) {
+	return AugmentedAssignmentExpression{n}, n.IsNamed() && n.Type() == "augmented_assignment_expression"
+}
+
+// Left returns the node in the left field.
+func (n AugmentedAssignmentExpression) Left() treesitter.Node {
+	return n.ChildByFieldName("left")
+}
+
+// Operator returns the node in the operator field.
+func (n AugmentedAssignmentExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// Right returns the node in the right field.
+func (n AugmentedAssignmentExpression) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// AwaitExpression wraps nodes of type await_expression.
+type AwaitExpression struct{ treesitter.Node }
+
+// AsAwaitExpression converts n to AwaitExpression, reporting whether it is of type await_expression.
+func AsAwaitExpression(n treesitter.Node) (AwaitExpression, bool) {
+	return AwaitExpression{n}, n.IsNamed() && n.Type() == "await_expression"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n AwaitExpression) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// BinaryExpression wraps nodes of type binary_expression.
+type BinaryExpression struct{ treesitter.Node }
+
+// AsBinaryExpression converts n to BinaryExpression, reporting whether it is of type binary_expression.
+func AsBinaryExpression(n treesitter.Node) (BinaryExpression, bool) {
+	return BinaryExpression{n}, n.IsNamed() && n.Type() == "binary_expression"
+}
+
+// Left returns the node in the left field.
+func (n BinaryExpression) Left() treesitter.Node {
+	return n.ChildByFieldName("left")
+}
+
+// Operator returns the node in the operator field.
+func (n BinaryExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// Right returns the node in the right field.
+func (n BinaryExpression) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// BreakStatement wraps nodes of type break_statement.
+type BreakStatement struct{ treesitter.Node }
+
+// AsBreakStatement converts n to BreakStatement, reporting whether it is of type break_statement.
+func AsBreakStatement(n treesitter.Node) (BreakStatement, bool) {
+	return BreakStatement{n}, n.IsNamed() && n.Type() == "break_statement"
+}
+
+// Label returns the node in the label field.
+func (n BreakStatement) Label() StatementIdentifier {
+	return StatementIdentifier{n.ChildByFieldName("label")}
+}
+
+// CallExpression wraps nodes of type call_expression.
+type CallExpression struct{ treesitter.Node }
+
+// AsCallExpression converts n to CallExpression, reporting whether it is of type call_expression.
+func AsCallExpression(n treesitter.Node) (CallExpression, bool) {
+	return CallExpression{n}, n.IsNamed() && n.Type() == "call_expression"
+}
+
+// Arguments returns the node in the arguments field.
+func (n CallExpression) Arguments() treesitter.Node {
+	return n.ChildByFieldName("arguments")
+}
+
+// Function returns the node in the function field.
+func (n CallExpression) Function() treesitter.Node {
+	return n.ChildByFieldName("function")
+}
+
+// OptionalChain returns the node in the optional_chain field.
+func (n CallExpression) OptionalChain() OptionalChain {
+	return OptionalChain{n.ChildByFieldName("optional_chain")}
+}
+
+// CatchClause wraps nodes of type catch_clause.
+type CatchClause struct{ treesitter.Node }
+
+// AsCatchClause converts n to CatchClause, reporting whether it is of type catch_clause.
+func AsCatchClause(n treesitter.Node) (CatchClause, bool) {
+	return CatchClause{n}, n.IsNamed() && n.Type() == "catch_clause"
+}
+
+// Body returns the node in the body field.
+func (n CatchClause) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// Parameter returns the node in the parameter field.
+func (n CatchClause) Parameter() treesitter.Node {
+	return n.ChildByFieldName("parameter")
+}
+
+// Class wraps nodes of type class.
+type Class struct{ treesitter.Node }
+
+// AsClass converts n to Class, reporting whether it is of type class.
+func AsClass(n treesitter.Node) (Class, bool) {
+	return Class{n}, n.IsNamed() && n.Type() == "class"
+}
+
+// Body returns the node in the body field.
+func (n Class) Body() ClassBody {
+	return ClassBody{n.ChildByFieldName("body")}
+}
+
+// Decorator returns the nodes in the decorator field.
+func (n Class) Decorator() []Decorator {
+	var nodes []Decorator
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "decorator" {
+			nodes = append(nodes, Decorator{c})
+		}
+	}
+	return nodes
+}
+
+// Name returns the node in the name field.
+func (n Class) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n Class) Content() ClassHeritage {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return ClassHeritage{c}
+		}
+	}
+	return ClassHeritage{}
+}
+
+// ClassBody wraps nodes of type class_body.
+type ClassBody struct{ treesitter.Node }
+
+// AsClassBody converts n to ClassBody, reporting whether it is of type class_body.
+func AsClassBody(n treesitter.Node) (ClassBody, bool) {
+	return ClassBody{n}, n.IsNamed() && n.Type() == "class_body"
+}
+
+// Member returns the nodes in the member field.
+func (n ClassBody) Member() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "member" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// Template returns the nodes in the template field.
+func (n ClassBody) Template() []GlimmerTemplate {
+	var nodes []GlimmerTemplate
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "template" {
+			nodes = append(nodes, GlimmerTemplate{c})
+		}
+	}
+	return nodes
+}
+
+// ClassDeclaration wraps nodes of type class_declaration.
+type ClassDeclaration struct{ treesitter.Node }
+
+// AsClassDeclaration converts n to ClassDeclaration, reporting whether it is of type class_declaration.
+func AsClassDeclaration(n treesitter.Node) (ClassDeclaration, bool) {
+	return ClassDeclaration{n}, n.IsNamed() && n.Type() == "class_declaration"
+}
+
+// Body returns the node in the body field.
+func (n ClassDeclaration) Body() ClassBody {
+	return ClassBody{n.ChildByFieldName("body")}
+}
+
+// Decorator returns the nodes in the decorator field.
+func (n ClassDeclaration) Decorator() []Decorator {
+	var nodes []Decorator
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "decorator" {
+			nodes = append(nodes, Decorator{c})
+		}
+	}
+	return nodes
+}
+
+// Name returns the node in the name field.
+func (n ClassDeclaration) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ClassDeclaration) Content() ClassHeritage {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return ClassHeritage{c}
+		}
+	}
+	return ClassHeritage{}
+}
+
+// ClassHeritage wraps nodes of type class_heritage.
+type ClassHeritage struct{ treesitter.Node }
+
+// AsClassHeritage converts n to ClassHeritage, reporting whether it is of type class_heritage.
+func AsClassHeritage(n treesitter.Node) (ClassHeritage, bool) {
+	return ClassHeritage{n}, n.IsNamed() && n.Type() == "class_heritage"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ClassHeritage) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// ClassStaticBlock wraps nodes of type class_static_block.
+type ClassStaticBlock struct{ treesitter.Node }
+
+// AsClassStaticBlock converts n to ClassStaticBlock, reporting whether it is of type class_static_block.
+func AsClassStaticBlock(n treesitter.Node) (ClassStaticBlock, bool) {
+	return ClassStaticBlock{n}, n.IsNamed() && n.Type() == "class_static_block"
+}
+
+// Body returns the node in the body field.
+func (n ClassStaticBlock) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// ComputedPropertyName wraps nodes of type computed_property_name.
+type ComputedPropertyName struct{ treesitter.Node }
+
+// AsComputedPropertyName converts n to ComputedPropertyName, reporting whether it is of type computed_property_name.
+func AsComputedPropertyName(n treesitter.Node) (ComputedPropertyName, bool) {
+	return ComputedPropertyName{n}, n.IsNamed() && n.Type() == "computed_property_name"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ComputedPropertyName) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// ContinueStatement wraps nodes of type continue_statement.
+type ContinueStatement struct{ treesitter.Node }
+
+// AsContinueStatement converts n to ContinueStatement, reporting whether it is of type continue_statement.
+func AsContinueStatement(n treesitter.Node) (ContinueStatement, bool) {
+	return ContinueStatement{n}, n.IsNamed() && n.Type() == "continue_statement"
+}
+
+// Label returns the node in the label field.
+func (n ContinueStatement) Label() StatementIdentifier {
+	return StatementIdentifier{n.ChildByFieldName("label")}
+}
+
+// DebuggerStatement wraps nodes of type debugger_statement.
+type DebuggerStatement struct{ treesitter.Node }
+
+// AsDebuggerStatement converts n to DebuggerStatement, reporting whether it is of type debugger_statement.
+func AsDebuggerStatement(n treesitter.Node) (DebuggerStatement, bool) {
+	return DebuggerStatement{n}, n.IsNamed() && n.Type() == "debugger_statement"
+}
+
+// Decorator wraps nodes of type decorator.
+type Decorator struct{ treesitter.Node }
+
+// AsDecorator converts n to Decorator, reporting whether it is of type decorator.
+func AsDecorator(n treesitter.Node) (Decorator, bool) {
+	return Decorator{n}, n.IsNamed() && n.Type() == "decorator"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n Decorator) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// DoStatement wraps nodes of type do_statement.
+type DoStatement struct{ treesitter.Node }
+
+// AsDoStatement converts n to DoStatement, reporting whether it is of type do_statement.
+func AsDoStatement(n treesitter.Node) (DoStatement, bool) {
+	return DoStatement{n}, n.IsNamed() && n.Type() == "do_statement"
+}
+
+// Body returns the node in the body field.
+func (n DoStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Condition returns the node in the condition field.
+func (n DoStatement) Condition() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("condition")}
+}
+
+// ElseClause wraps nodes of type else_clause.
+type ElseClause struct{ treesitter.Node }
+
+// AsElseClause converts n to ElseClause, reporting whether it is of type else_clause.
+func AsElseClause(n treesitter.Node) (ElseClause, bool) {
+	return ElseClause{n}, n.IsNamed() && n.Type() == "else_clause"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ElseClause) Content() Statement {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Statement{c}
+		}
+	}
+	return Statement{}
+}
+
+// EmptyStatement wraps nodes of type empty_statement.
+type EmptyStatement struct{ treesitter.Node }
+
+// AsEmptyStatement converts n to EmptyStatement, reporting whether it is of type empty_statement.
+func AsEmptyStatement(n treesitter.Node) (EmptyStatement, bool) {
+	return EmptyStatement{n}, n.IsNamed() && n.Type() == "empty_statement"
+}
+
+// ExportClause wraps nodes of type export_clause.
+type ExportClause struct{ treesitter.Node }
+
+// AsExportClause converts n to ExportClause, reporting whether it is of type export_clause.
+func AsExportClause(n treesitter.Node) (ExportClause, bool) {
+	return ExportClause{n}, n.IsNamed() && n.Type() == "export_clause"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ExportClause) Contents() []ExportSpecifier {
+	var nodes []ExportSpecifier
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, ExportSpecifier{c})
+		}
+	}
+	return nodes
+}
+
+// ExportSpecifier wraps nodes of type export_specifier.
+type ExportSpecifier struct{ treesitter.Node }
+
+// AsExportSpecifier converts n to ExportSpecifier, reporting whether it is of type export_specifier.
+func AsExportSpecifier(n treesitter.Node) (ExportSpecifier, bool) {
+	return ExportSpecifier{n}, n.IsNamed() && n.Type() == "export_specifier"
+}
+
+// Alias returns the node in the alias field.
+func (n ExportSpecifier) Alias() treesitter.Node {
+	return n.ChildByFieldName("alias")
+}
+
+// Name returns the node in the name field.
+func (n ExportSpecifier) Name() treesitter.Node {
+	return n.ChildByFieldName("name")
+}
+
+// ExportStatement wraps nodes of type export_statement.
+type ExportStatement struct{ treesitter.Node }
+
+// AsExportStatement converts n to ExportStatement, reporting whether it is of type export_statement.
+func AsExportStatement(n treesitter.Node) (ExportStatement, bool) {
+	return ExportStatement{n}, n.IsNamed() && n.Type() == "export_statement"
+}
+
+// Declaration returns the node in the declaration field.
+func (n ExportStatement) Declaration() Declaration {
+	return Declaration{n.ChildByFieldName("declaration")}
+}
+
+// Decorator returns the nodes in the decorator field.
+func (n ExportStatement) Decorator() []Decorator {
+	var nodes []Decorator
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "decorator" {
+			nodes = append(nodes, Decorator{c})
+		}
+	}
+	return nodes
+}
+
+// Source returns the node in the source field.
+func (n ExportStatement) Source() String {
+	return String{n.ChildByFieldName("source")}
+}
+
+// Value returns the node in the value field.
+func (n ExportStatement) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ExportStatement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// ExpressionStatement wraps nodes of type expression_statement.
+type ExpressionStatement struct{ treesitter.Node }
+
+// AsExpressionStatement converts n to ExpressionStatement, reporting whether it is of type expression_statement.
+func AsExpressionStatement(n treesitter.Node) (ExpressionStatement, bool) {
+	return ExpressionStatement{n}, n.IsNamed() && n.Type() == "expression_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ExpressionStatement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// FieldDefinition wraps nodes of type field_definition.
+type FieldDefinition struct{ treesitter.Node }
+
+// AsFieldDefinition converts n to FieldDefinition, reporting whether it is of type field_definition.
+func AsFieldDefinition(n treesitter.Node) (FieldDefinition, bool) {
+	return FieldDefinition{n}, n.IsNamed() && n.Type() == "field_definition"
+}
+
+// Decorator returns the nodes in the decorator field.
+func (n FieldDefinition) Decorator() []Decorator {
+	var nodes []Decorator
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "decorator" {
+			nodes = append(nodes, Decorator{c})
+		}
+	}
+	return nodes
+}
+
+// Property returns the node in the property field.
+func (n FieldDefinition) Property() treesitter.Node {
+	return n.ChildByFieldName("property")
+}
+
+// Value returns the node in the value field.
+func (n FieldDefinition) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// FinallyClause wraps nodes of type finally_clause.
+type FinallyClause struct{ treesitter.Node }
+
+// AsFinallyClause converts n to FinallyClause, reporting whether it is of type finally_clause.
+func AsFinallyClause(n treesitter.Node) (FinallyClause, bool) {
+	return FinallyClause{n}, n.IsNamed() && n.Type() == "finally_clause"
+}
+
+// Body returns the node in the body field.
+func (n FinallyClause) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// ForInStatement wraps nodes of type for_in_statement.
+type ForInStatement struct{ treesitter.Node }
+
+// AsForInStatement converts n to ForInStatement, reporting whether it is of type for_in_statement.
+func AsForInStatement(n treesitter.Node) (ForInStatement, bool) {
+	return ForInStatement{n}, n.IsNamed() && n.Type() == "for_in_statement"
+}
+
+// Body returns the node in the body field.
+func (n ForInStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Kind returns the node in the kind field.
+func (n ForInStatement) Kind() treesitter.Node {
+	return n.ChildByFieldName("kind")
+}
+
+// Left returns the node in the left field.
+func (n ForInStatement) Left() treesitter.Node {
+	return n.ChildByFieldName("left")
+}
+
+// Operator returns the node in the operator field.
+func (n ForInStatement) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// Right returns the node in the right field.
+func (n ForInStatement) Right() treesitter.Node {
+	return n.ChildByFieldName("right")
+}
+
+// Value returns the node in the value field.
+func (n ForInStatement) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// ForStatement wraps nodes of type for_statement.
+type ForStatement struct{ treesitter.Node }
+
+// AsForStatement converts n to ForStatement, reporting whether it is of type for_statement.
+func AsForStatement(n treesitter.Node) (ForStatement, bool) {
+	return ForStatement{n}, n.IsNamed() && n.Type() == "for_statement"
+}
+
+// Body returns the node in the body field.
+func (n ForStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Condition returns the node in the condition field.
+func (n ForStatement) Condition() treesitter.Node {
+	return n.ChildByFieldName("condition")
+}
+
+// Increment returns the node in the increment field.
+func (n ForStatement) Increment() treesitter.Node {
+	return n.ChildByFieldName("increment")
+}
+
+// Initializer returns the node in the initializer field.
+func (n ForStatement) Initializer() treesitter.Node {
+	return n.ChildByFieldName("initializer")
+}
+
+// FormalParameters wraps nodes of type formal_parameters.
+type FormalParameters struct{ treesitter.Node }
+
+// AsFormalParameters converts n to FormalParameters, reporting whether it is of type formal_parameters.
+func AsFormalParameters(n treesitter.Node) (FormalParameters, bool) {
+	return FormalParameters{n}, n.IsNamed() && n.Type() == "formal_parameters"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n FormalParameters) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// FunctionDeclaration wraps nodes of type function_declaration.
+type FunctionDeclaration struct{ treesitter.Node }
+
+// AsFunctionDeclaration converts n to FunctionDeclaration, reporting whether it is of type function_declaration.
+func AsFunctionDeclaration(n treesitter.Node) (FunctionDeclaration, bool) {
+	return FunctionDeclaration{n}, n.IsNamed() && n.Type() == "function_declaration"
+}
+
+// Body returns the node in the body field.
+func (n FunctionDeclaration) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n FunctionDeclaration) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n FunctionDeclaration) Parameters() FormalParameters {
+	return FormalParameters{n.ChildByFieldName("parameters")}
+}
+
+// FunctionExpression wraps nodes of type function_expression.
+type FunctionExpression struct{ treesitter.Node }
+
+// AsFunctionExpression converts n to FunctionExpression, reporting whether it is of type function_expression.
+func AsFunctionExpression(n treesitter.Node) (FunctionExpression, bool) {
+	return FunctionExpression{n}, n.IsNamed() && n.Type() == "function_expression"
+}
+
+// Body returns the node in the body field.
+func (n FunctionExpression) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n FunctionExpression) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n FunctionExpression) Parameters() FormalParameters {
+	return FormalParameters{n.ChildByFieldName("parameters")}
+}
+
+// GeneratorFunction wraps nodes of type generator_function.
+type GeneratorFunction struct{ treesitter.Node }
+
+// AsGeneratorFunction converts n to GeneratorFunction, reporting whether it is of type generator_function.
+func AsGeneratorFunction(n treesitter.Node) (GeneratorFunction, bool) {
+	return GeneratorFunction{n}, n.IsNamed() && n.Type() == "generator_function"
+}
+
+// Body returns the node in the body field.
+func (n GeneratorFunction) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n GeneratorFunction) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n GeneratorFunction) Parameters() FormalParameters {
+	return FormalParameters{n.ChildByFieldName("parameters")}
+}
+
+// GeneratorFunctionDeclaration wraps nodes of type generator_function_declaration.
+type GeneratorFunctionDeclaration struct{ treesitter.Node }
+
+// AsGeneratorFunctionDeclaration converts n to GeneratorFunctionDeclaration, reporting whether it is of type generator_function_declaration.
+func AsGeneratorFunctionDeclaration(n treesitter.Node) (GeneratorFunctionDeclaration, bool) {
+	return GeneratorFunctionDeclaration{n}, n.IsNamed() && n.Type() == "generator_function_declaration"
+}
+
+// Body returns the node in the body field.
+func (n GeneratorFunctionDeclaration) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// Name returns the node in the name field.
+func (n GeneratorFunctionDeclaration) Name() Identifier {
+	return Identifier{n.ChildByFieldName("name")}
+}
+
+// Parameters returns the node in the parameters field.
+func (n GeneratorFunctionDeclaration) Parameters() FormalParameters {
+	return FormalParameters{n.ChildByFieldName("parameters")}
+}
+
+// GlimmerTemplate wraps nodes of type glimmer_template.
+type GlimmerTemplate struct{ treesitter.Node }
+
+// AsGlimmerTemplate converts n to GlimmerTemplate, reporting whether it is of type glimmer_template.
+func AsGlimmerTemplate(n treesitter.Node) (GlimmerTemplate, bool) {
+	return GlimmerTemplate{n}, n.IsNamed() && n.Type() == "glimmer_template"
+}
+
+// CloseTag returns the node in the close_tag field.
+func (n GlimmerTemplate) CloseTag() GlimmerClosingTag {
+	return GlimmerClosingTag{n.ChildByFieldName("close_tag")}
+}
+
+// Content returns the node in the content field.
+func (n GlimmerTemplate) Content() treesitter.Node {
+	return n.ChildByFieldName("content")
+}
+
+// OpenTag returns the node in the open_tag field.
+func (n GlimmerTemplate) OpenTag() GlimmerOpeningTag {
+	return GlimmerOpeningTag{n.ChildByFieldName("open_tag")}
+}
+
+// IfStatement wraps nodes of type if_statement.
+type IfStatement struct{ treesitter.Node }
+
+// AsIfStatement converts n to IfStatement, reporting whether it is of type if_statement.
+func AsIfStatement(n treesitter.Node) (IfStatement, bool) {
+	return IfStatement{n}, n.IsNamed() && n.Type() == "if_statement"
+}
+
+// Alternative returns the node in the alternative field.
+func (n IfStatement) Alternative() ElseClause {
+	return ElseClause{n.ChildByFieldName("alternative")}
+}
+
+// Condition returns the node in the condition field.
+func (n IfStatement) Condition() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("condition")}
+}
+
+// Consequence returns the node in the consequence field.
+func (n IfStatement) Consequence() Statement {
+	return Statement{n.ChildByFieldName("consequence")}
+}
+
+// Import wraps nodes of type import.
+type Import struct{ treesitter.Node }
+
+// AsImport converts n to Import, reporting whether it is of type import.
+func AsImport(n treesitter.Node) (Import, bool) {
+	return Import{n}, n.IsNamed() && n.Type() == "import"
+}
+
+// ImportAttribute wraps nodes of type import_attribute.
+type ImportAttribute struct{ treesitter.Node }
+
+// AsImportAttribute converts n to ImportAttribute, reporting whether it is of type import_attribute.
+func AsImportAttribute(n treesitter.Node) (ImportAttribute, bool) {
+	return ImportAttribute{n}, n.IsNamed() && n.Type() == "import_attribute"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ImportAttribute) Content() Object {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Object{c}
+		}
+	}
+	return Object{}
+}
+
+// ImportClause wraps nodes of type import_clause.
+type ImportClause struct{ treesitter.Node }
+
+// AsImportClause converts n to ImportClause, reporting whether it is of type import_clause.
+func AsImportClause(n treesitter.Node) (ImportClause, bool) {
+	return ImportClause{n}, n.IsNamed() && n.Type() == "import_clause"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ImportClause) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ImportSpecifier wraps nodes of type import_specifier.
+type ImportSpecifier struct{ treesitter.Node }
+
+// AsImportSpecifier converts n to ImportSpecifier, reporting whether it is of type import_specifier.
+func AsImportSpecifier(n treesitter.Node) (ImportSpecifier, bool) {
+	return ImportSpecifier{n}, n.IsNamed() && n.Type() == "import_specifier"
+}
+
+// Alias returns the node in the alias field.
+func (n ImportSpecifier) Alias() Identifier {
+	return Identifier{n.ChildByFieldName("alias")}
+}
+
+// Name returns the node in the name field.
+func (n ImportSpecifier) Name() treesitter.Node {
+	return n.ChildByFieldName("name")
+}
+
+// ImportStatement wraps nodes of type import_statement.
+type ImportStatement struct{ treesitter.Node }
+
+// AsImportStatement converts n to ImportStatement, reporting whether it is of type import_statement.
+func AsImportStatement(n treesitter.Node) (ImportStatement, bool) {
+	return ImportStatement{n}, n.IsNamed() && n.Type() == "import_statement"
+}
+
+// Source returns the node in the source field.
+func (n ImportStatement) Source() String {
+	return String{n.ChildByFieldName("source")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ImportStatement) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// JsxAttribute wraps nodes of type jsx_attribute.
+type JsxAttribute struct{ treesitter.Node }
+
+// AsJsxAttribute converts n to JsxAttribute, reporting whether it is of type jsx_attribute.
+func AsJsxAttribute(n treesitter.Node) (JsxAttribute, bool) {
+	return JsxAttribute{n}, n.IsNamed() && n.Type() == "jsx_attribute"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n JsxAttribute) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// JsxClosingElement wraps nodes of type jsx_closing_element.
+type JsxClosingElement struct{ treesitter.Node }
+
+// AsJsxClosingElement converts n to JsxClosingElement, reporting whether it is of type jsx_closing_element.
+func AsJsxClosingElement(n treesitter.Node) (JsxClosingElement, bool) {
+	return JsxClosingElement{n}, n.IsNamed() && n.Type() == "jsx_closing_element"
+}
+
+// Name returns the node in the name field.
+func (n JsxClosingElement) Name() treesitter.Node {
+	return n.ChildByFieldName("name")
+}
+
+// JsxElement wraps nodes of type jsx_element.
+type JsxElement struct{ treesitter.Node }
+
+// AsJsxElement converts n to JsxElement, reporting whether it is of type jsx_element.
+func AsJsxElement(n treesitter.Node) (JsxElement, bool) {
+	return JsxElement{n}, n.IsNamed() && n.Type() == "jsx_element"
+}
+
+// CloseTag returns the node in the close_tag field.
+func (n JsxElement) CloseTag() JsxClosingElement {
+	return JsxClosingElement{n.ChildByFieldName("close_tag")}
+}
+
+// OpenTag returns the node in the open_tag field.
+func (n JsxElement) OpenTag() JsxOpeningElement {
+	return JsxOpeningElement{n.ChildByFieldName("open_tag")}
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n JsxElement) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// JsxExpression wraps nodes of type jsx_expression.
+type JsxExpression struct{ treesitter.Node }
+
+// AsJsxExpression converts n to JsxExpression, reporting whether it is of type jsx_expression.
+func AsJsxExpression(n treesitter.Node) (JsxExpression, bool) {
+	return JsxExpression{n}, n.IsNamed() && n.Type() == "jsx_expression"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n JsxExpression) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// JsxNamespaceName wraps nodes of type jsx_namespace_name.
+type JsxNamespaceName struct{ treesitter.Node }
+
+// AsJsxNamespaceName converts n to JsxNamespaceName, reporting whether it is of type jsx_namespace_name.
+func AsJsxNamespaceName(n treesitter.Node) (JsxNamespaceName, bool) {
+	return JsxNamespaceName{n}, n.IsNamed() && n.Type() == "jsx_namespace_name"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n JsxNamespaceName) Contents() []Identifier {
+	var nodes []Identifier
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, Identifier{c})
+		}
+	}
+	return nodes
+}
+
+// JsxOpeningElement wraps nodes of type jsx_opening_element.
+type JsxOpeningElement struct{ treesitter.Node }
+
+// AsJsxOpeningElement converts n to JsxOpeningElement, reporting whether it is of type jsx_opening_element.
+func AsJsxOpeningElement(n treesitter.Node) (JsxOpeningElement, bool) {
+	return JsxOpeningElement{n}, n.IsNamed() && n.Type() == "jsx_opening_element"
+}
+
+// Attribute returns the nodes in the attribute field.
+func (n JsxOpeningElement) Attribute() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "attribute" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// Name returns the node in the name field.
+func (n JsxOpeningElement) Name() treesitter.Node {
+	return n.ChildByFieldName("name")
+}
+
+// JsxSelfClosingElement wraps nodes of type jsx_self_closing_element.
+type JsxSelfClosingElement struct{ treesitter.Node }
+
+// AsJsxSelfClosingElement converts n to JsxSelfClosingElement, reporting whether it is of type jsx_self_closing_element.
+func AsJsxSelfClosingElement(n treesitter.Node) (JsxSelfClosingElement, bool) {
+	return JsxSelfClosingElement{n}, n.IsNamed() && n.Type() == "jsx_self_closing_element"
+}
+
+// Attribute returns the nodes in the attribute field.
+func (n JsxSelfClosingElement) Attribute() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "attribute" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// Name returns the node in the name field.
+func (n JsxSelfClosingElement) Name() treesitter.Node {
+	return n.ChildByFieldName("name")
+}
+
+// JsxText wraps nodes of type jsx_text.
+type JsxText struct{ treesitter.Node }
+
+// AsJsxText converts n to JsxText, reporting whether it is of type jsx_text.
+func AsJsxText(n treesitter.Node) (JsxText, bool) {
+	return JsxText{n}, n.IsNamed() && n.Type() == "jsx_text"
+}
+
+// LabeledStatement wraps nodes of type labeled_statement.
+type LabeledStatement struct{ treesitter.Node }
+
+// AsLabeledStatement converts n to LabeledStatement, reporting whether it is of type labeled_statement.
+func AsLabeledStatement(n treesitter.Node) (LabeledStatement, bool) {
+	return LabeledStatement{n}, n.IsNamed() && n.Type() == "labeled_statement"
+}
+
+// Body returns the node in the body field.
+func (n LabeledStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Label returns the node in the label field.
+func (n LabeledStatement) Label() StatementIdentifier {
+	return StatementIdentifier{n.ChildByFieldName("label")}
+}
+
+// LexicalDeclaration wraps nodes of type lexical_declaration.
+type LexicalDeclaration struct{ treesitter.Node }
+
+// AsLexicalDeclaration converts n to LexicalDeclaration, reporting whether it is of type lexical_declaration.
+func AsLexicalDeclaration(n treesitter.Node) (LexicalDeclaration, bool) {
+	return LexicalDeclaration{n}, n.IsNamed() && n.Type() == "lexical_declaration"
+}
+
+// Kind returns the node in the kind field.
+func (n LexicalDeclaration) Kind() treesitter.Node {
+	return n.ChildByFieldName("kind")
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n LexicalDeclaration) Contents() []VariableDeclarator {
+	var nodes []VariableDeclarator
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, VariableDeclarator{c})
+		}
+	}
+	return nodes
+}
+
+// MemberExpression wraps nodes of type member_expression.
+type MemberExpression struct{ treesitter.Node }
+
+// AsMemberExpression converts n to MemberExpression, reporting whether it is of type member_expression.
+func AsMemberExpression(n treesitter.Node) (MemberExpression, bool) {
+	return MemberExpression{n}, n.IsNamed() && n.Type() == "member_expression"
+}
+
+// Object returns the node in the object field.
+func (n MemberExpression) Object() treesitter.Node {
+	return n.ChildByFieldName("object")
+}
+
+// OptionalChain returns the node in the optional_chain field.
+func (n MemberExpression) OptionalChain() OptionalChain {
+	return OptionalChain{n.ChildByFieldName("optional_chain")}
+}
+
+// Property returns the node in the property field.
+func (n MemberExpression) Property() treesitter.Node {
+	return n.ChildByFieldName("property")
+}
+
+// MetaProperty wraps nodes of type meta_property.
+type MetaProperty struct{ treesitter.Node }
+
+// AsMetaProperty converts n to MetaProperty, reporting whether it is of type meta_property.
+func AsMetaProperty(n treesitter.Node) (MetaProperty, bool) {
+	return MetaProperty{n}, n.IsNamed() && n.Type() == "meta_property"
+}
+
+// MethodDefinition wraps nodes of type method_definition.
+type MethodDefinition struct{ treesitter.Node }
+
+// AsMethodDefinition converts n to MethodDefinition, reporting whether it is of type method_definition.
+func AsMethodDefinition(n treesitter.Node) (MethodDefinition, bool) {
+	return MethodDefinition{n}, n.IsNamed() && n.Type() == "method_definition"
+}
+
+// Body returns the node in the body field.
+func (n MethodDefinition) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// Decorator returns the nodes in the decorator field.
+func (n MethodDefinition) Decorator() []Decorator {
+	var nodes []Decorator
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "decorator" {
+			nodes = append(nodes, Decorator{c})
+		}
+	}
+	return nodes
+}
+
+// Name returns the node in the name field.
+func (n MethodDefinition) Name() treesitter.Node {
+	return n.ChildByFieldName("name")
+}
+
+// Parameters returns the node in the parameters field.
+func (n MethodDefinition) Parameters() FormalParameters {
+	return FormalParameters{n.ChildByFieldName("parameters")}
+}
+
+// NamedImports wraps nodes of type named_imports.
+type NamedImports struct{ treesitter.Node }
+
+// AsNamedImports converts n to NamedImports, reporting whether it is of type named_imports.
+func AsNamedImports(n treesitter.Node) (NamedImports, bool) {
+	return NamedImports{n}, n.IsNamed() && n.Type() == "named_imports"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n NamedImports) Contents() []ImportSpecifier {
+	var nodes []ImportSpecifier
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, ImportSpecifier{c})
+		}
+	}
+	return nodes
+}
+
+// NamespaceExport wraps nodes of type namespace_export.
+type NamespaceExport struct{ treesitter.Node }
+
+// AsNamespaceExport converts n to NamespaceExport, reporting whether it is of type namespace_export.
+func AsNamespaceExport(n treesitter.Node) (NamespaceExport, bool) {
+	return NamespaceExport{n}, n.IsNamed() && n.Type() == "namespace_export"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n NamespaceExport) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// NamespaceImport wraps nodes of type namespace_import.
+type NamespaceImport struct{ treesitter.Node }
+
+// AsNamespaceImport converts n to NamespaceImport, reporting whether it is of type namespace_import.
+func AsNamespaceImport(n treesitter.Node) (NamespaceImport, bool) {
+	return NamespaceImport{n}, n.IsNamed() && n.Type() == "namespace_import"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n NamespaceImport) Content() Identifier {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Identifier{c}
+		}
+	}
+	return Identifier{}
+}
+
+// NewExpression wraps nodes of type new_expression.
+type NewExpression struct{ treesitter.Node }
+
+// AsNewExpression converts n to NewExpression, reporting whether it is of type new_expression.
+func AsNewExpression(n treesitter.Node) (NewExpression, bool) {
+	return NewExpression{n}, n.IsNamed() && n.Type() == "new_expression"
+}
+
+// Arguments returns the node in the arguments field.
+func (n NewExpression) Arguments() Arguments {
+	return Arguments{n.ChildByFieldName("arguments")}
+}
+
+// Constructor returns the node in the constructor field.
+func (n NewExpression) Constructor() treesitter.Node {
+	return n.ChildByFieldName("constructor")
+}
+
+// Object wraps nodes of type object.
+type Object struct{ treesitter.Node }
+
+// AsObject converts n to Object, reporting whether it is of type object.
+func AsObject(n treesitter.Node) (Object, bool) {
+	return Object{n}, n.IsNamed() && n.Type() == "object"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n Object) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// ObjectAssignmentPattern wraps nodes of type object_assignment_pattern.
+type ObjectAssignmentPattern struct{ treesitter.Node }
+
+// AsObjectAssignmentPattern converts n to ObjectAssignmentPattern, reporting whether it is of type object_assignment_pattern.
+func AsObjectAssignmentPattern(n treesitter.Node) (ObjectAssignmentPattern, bool) {
+	return ObjectAssignmentPattern{n}, n.IsNamed() && n.Type() == "object_assignment_pattern"
+}
+
+// Left returns the node in the left field.
+func (n ObjectAssignmentPattern) Left() treesitter.Node {
+	return n.ChildByFieldName("left")
+}
+
+// Right returns the node in the right field.
+func (n ObjectAssignmentPattern) Right() Expression {
+	return Expression{n.ChildByFieldName("right")}
+}
+
+// ObjectPattern wraps nodes of type object_pattern.
+type ObjectPattern struct{ treesitter.Node }
+
+// AsObjectPattern converts n to ObjectPattern, reporting whether it is of type object_pattern.
+func AsObjectPattern(n treesitter.Node) (ObjectPattern, bool) {
+	return ObjectPattern{n}, n.IsNamed() && n.Type() == "object_pattern"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n ObjectPattern) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// Pair wraps nodes of type pair.
+type Pair struct{ treesitter.Node }
+
+// AsPair converts n to Pair, reporting whether it is of type pair.
+func AsPair(n treesitter.Node) (Pair, bool) {
+	return Pair{n}, n.IsNamed() && n.Type() == "pair"
+}
+
+// Key returns the node in the key field.
+func (n Pair) Key() treesitter.Node {
+	return n.ChildByFieldName("key")
+}
+
+// Value returns the node in the value field.
+func (n Pair) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// PairPattern wraps nodes of type pair_pattern.
+type PairPattern struct{ treesitter.Node }
+
+// AsPairPattern converts n to PairPattern, reporting whether it is of type pair_pattern.
+func AsPairPattern(n treesitter.Node) (PairPattern, bool) {
+	return PairPattern{n}, n.IsNamed() && n.Type() == "pair_pattern"
+}
+
+// Key returns the node in the key field.
+func (n PairPattern) Key() treesitter.Node {
+	return n.ChildByFieldName("key")
+}
+
+// Value returns the node in the value field.
+func (n PairPattern) Value() treesitter.Node {
+	return n.ChildByFieldName("value")
+}
+
+// ParenthesizedExpression wraps nodes of type parenthesized_expression.
+type ParenthesizedExpression struct{ treesitter.Node }
+
+// AsParenthesizedExpression converts n to ParenthesizedExpression, reporting whether it is of type parenthesized_expression.
+func AsParenthesizedExpression(n treesitter.Node) (ParenthesizedExpression, bool) {
+	return ParenthesizedExpression{n}, n.IsNamed() && n.Type() == "parenthesized_expression"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ParenthesizedExpression) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// Program wraps nodes of type program.
+type Program struct{ treesitter.Node }
+
+// AsProgram converts n to Program, reporting whether it is of type program.
+func AsProgram(n treesitter.Node) (Program, bool) {
+	return Program{n}, n.IsNamed() && n.Type() == "program"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n Program) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// Regex wraps nodes of type regex.
+type Regex struct{ treesitter.Node }
+
+// AsRegex converts n to Regex, reporting whether it is of type regex.
+func AsRegex(n treesitter.Node) (Regex, bool) {
+	return Regex{n}, n.IsNamed() && n.Type() == "regex"
+}
+
+// Flags returns the node in the flags field.
+func (n Regex) Flags() RegexFlags {
+	return RegexFlags{n.ChildByFieldName("flags")}
+}
+
+// Pattern returns the node in the pattern field.
+func (n Regex) Pattern() RegexPattern {
+	return RegexPattern{n.ChildByFieldName("pattern")}
+}
+
+// RestPattern wraps nodes of type rest_pattern.
+type RestPattern struct{ treesitter.Node }
+
+// AsRestPattern converts n to RestPattern, reporting whether it is of type rest_pattern.
+func AsRestPattern(n treesitter.Node) (RestPattern, bool) {
+	return RestPattern{n}, n.IsNamed() && n.Type() == "rest_pattern"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n RestPattern) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// ReturnStatement wraps nodes of type return_statement.
+type ReturnStatement struct{ treesitter.Node }
+
+// AsReturnStatement converts n to ReturnStatement, reporting whether it is of type return_statement.
+func AsReturnStatement(n treesitter.Node) (ReturnStatement, bool) {
+	return ReturnStatement{n}, n.IsNamed() && n.Type() == "return_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ReturnStatement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// SequenceExpression wraps nodes of type sequence_expression.
+type SequenceExpression struct{ treesitter.Node }
+
+// AsSequenceExpression converts n to SequenceExpression, reporting whether it is of type sequence_expression.
+func AsSequenceExpression(n treesitter.Node) (SequenceExpression, bool) {
+	return SequenceExpression{n}, n.IsNamed() && n.Type() == "sequence_expression"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n SequenceExpression) Contents() []Expression {
+	var nodes []Expression
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, Expression{c})
+		}
+	}
+	return nodes
+}
+
+// SpreadElement wraps nodes of type spread_element.
+type SpreadElement struct{ treesitter.Node }
+
+// AsSpreadElement converts n to SpreadElement, reporting whether it is of type spread_element.
+func AsSpreadElement(n treesitter.Node) (SpreadElement, bool) {
+	return SpreadElement{n}, n.IsNamed() && n.Type() == "spread_element"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n SpreadElement) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// StatementBlock wraps nodes of type statement_block.
+type StatementBlock struct{ treesitter.Node }
+
+// AsStatementBlock converts n to StatementBlock, reporting whether it is of type statement_block.
+func AsStatementBlock(n treesitter.Node) (StatementBlock, bool) {
+	return StatementBlock{n}, n.IsNamed() && n.Type() == "statement_block"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n StatementBlock) Contents() []Statement {
+	var nodes []Statement
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, Statement{c})
+		}
+	}
+	return nodes
+}
+
+// String wraps nodes of type string.
+type String struct{ treesitter.Node }
+
+// AsString converts n to String, reporting whether it is of type string.
+func AsString(n treesitter.Node) (String, bool) {
+	return String{n}, n.IsNamed() && n.Type() == "string"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n String) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// SubscriptExpression wraps nodes of type subscript_expression.
+type SubscriptExpression struct{ treesitter.Node }
+
+// AsSubscriptExpression converts n to SubscriptExpression, reporting whether it is of type subscript_expression.
+func AsSubscriptExpression(n treesitter.Node) (SubscriptExpression, bool) {
+	return SubscriptExpression{n}, n.IsNamed() && n.Type() == "subscript_expression"
+}
+
+// Index returns the node in the index field.
+func (n SubscriptExpression) Index() treesitter.Node {
+	return n.ChildByFieldName("index")
+}
+
+// Object returns the node in the object field.
+func (n SubscriptExpression) Object() treesitter.Node {
+	return n.ChildByFieldName("object")
+}
+
+// OptionalChain returns the node in the optional_chain field.
+func (n SubscriptExpression) OptionalChain() OptionalChain {
+	return OptionalChain{n.ChildByFieldName("optional_chain")}
+}
+
+// SwitchBody wraps nodes of type switch_body.
+type SwitchBody struct{ treesitter.Node }
+
+// AsSwitchBody converts n to SwitchBody, reporting whether it is of type switch_body.
+func AsSwitchBody(n treesitter.Node) (SwitchBody, bool) {
+	return SwitchBody{n}, n.IsNamed() && n.Type() == "switch_body"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n SwitchBody) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// SwitchCase wraps nodes of type switch_case.
+type SwitchCase struct{ treesitter.Node }
+
+// AsSwitchCase converts n to SwitchCase, reporting whether it is of type switch_case.
+func AsSwitchCase(n treesitter.Node) (SwitchCase, bool) {
+	return SwitchCase{n}, n.IsNamed() && n.Type() == "switch_case"
+}
+
+// Body returns the nodes in the body field.
+func (n SwitchCase) Body() []Statement {
+	var nodes []Statement
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "body" {
+			nodes = append(nodes, Statement{c})
+		}
+	}
+	return nodes
+}
+
+// Value returns the node in the value field.
+func (n SwitchCase) Value() treesitter.Node {
+	return n.ChildByFieldName("value")
+}
+
+// SwitchDefault wraps nodes of type switch_default.
+type SwitchDefault struct{ treesitter.Node }
+
+// AsSwitchDefault converts n to SwitchDefault, reporting whether it is of type switch_default.
+func AsSwitchDefault(n treesitter.Node) (SwitchDefault, bool) {
+	return SwitchDefault{n}, n.IsNamed() && n.Type() == "switch_default"
+}
+
+// Body returns the nodes in the body field.
+func (n SwitchDefault) Body() []Statement {
+	var nodes []Statement
+	for i, c := range n.Children() {
+		if n.FieldNameForChild(i) == "body" {
+			nodes = append(nodes, Statement{c})
+		}
+	}
+	return nodes
+}
+
+// SwitchStatement wraps nodes of type switch_statement.
+type SwitchStatement struct{ treesitter.Node }
+
+// AsSwitchStatement converts n to SwitchStatement, reporting whether it is of type switch_statement.
+func AsSwitchStatement(n treesitter.Node) (SwitchStatement, bool) {
+	return SwitchStatement{n}, n.IsNamed() && n.Type() == "switch_statement"
+}
+
+// Body returns the node in the body field.
+func (n SwitchStatement) Body() SwitchBody {
+	return SwitchBody{n.ChildByFieldName("body")}
+}
+
+// Value returns the node in the value field.
+func (n SwitchStatement) Value() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("value")}
+}
+
+// TemplateString wraps nodes of type template_string.
+type TemplateString struct{ treesitter.Node }
+
+// AsTemplateString converts n to TemplateString, reporting whether it is of type template_string.
+func AsTemplateString(n treesitter.Node) (TemplateString, bool) {
+	return TemplateString{n}, n.IsNamed() && n.Type() == "template_string"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n TemplateString) Contents() []treesitter.Node {
+	var nodes []treesitter.Node
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// TemplateSubstitution wraps nodes of type template_substitution.
+type TemplateSubstitution struct{ treesitter.Node }
+
+// AsTemplateSubstitution converts n to TemplateSubstitution, reporting whether it is of type template_substitution.
+func AsTemplateSubstitution(n treesitter.Node) (TemplateSubstitution, bool) {
+	return TemplateSubstitution{n}, n.IsNamed() && n.Type() == "template_substitution"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n TemplateSubstitution) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// TernaryExpression wraps nodes of type ternary_expression.
+type TernaryExpression struct{ treesitter.Node }
+
+// AsTernaryExpression converts n to TernaryExpression, reporting whether it is of type ternary_expression.
+func AsTernaryExpression(n treesitter.Node) (TernaryExpression, bool) {
+	return TernaryExpression{n}, n.IsNamed() && n.Type() == "ternary_expression"
+}
+
+// Alternative returns the node in the alternative field.
+func (n TernaryExpression) Alternative() Expression {
+	return Expression{n.ChildByFieldName("alternative")}
+}
+
+// Condition returns the node in the condition field.
+func (n TernaryExpression) Condition() Expression {
+	return Expression{n.ChildByFieldName("condition")}
+}
+
+// Consequence returns the node in the consequence field.
+func (n TernaryExpression) Consequence() Expression {
+	return Expression{n.ChildByFieldName("consequence")}
+}
+
+// ThrowStatement wraps nodes of type throw_statement.
+type ThrowStatement struct{ treesitter.Node }
+
+// AsThrowStatement converts n to ThrowStatement, reporting whether it is of type throw_statement.
+func AsThrowStatement(n treesitter.Node) (ThrowStatement, bool) {
+	return ThrowStatement{n}, n.IsNamed() && n.Type() == "throw_statement"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n ThrowStatement) Content() treesitter.Node {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return c
+		}
+	}
+	return treesitter.Node{}
+}
+
+// TryStatement wraps nodes of type try_statement.
+type TryStatement struct{ treesitter.Node }
+
+// AsTryStatement converts n to TryStatement, reporting whether it is of type try_statement.
+func AsTryStatement(n treesitter.Node) (TryStatement, bool) {
+	return TryStatement{n}, n.IsNamed() && n.Type() == "try_statement"
+}
+
+// Body returns the node in the body field.
+func (n TryStatement) Body() StatementBlock {
+	return StatementBlock{n.ChildByFieldName("body")}
+}
+
+// Finalizer returns the node in the finalizer field.
+func (n TryStatement) Finalizer() FinallyClause {
+	return FinallyClause{n.ChildByFieldName("finalizer")}
+}
+
+// Handler returns the node in the handler field.
+func (n TryStatement) Handler() CatchClause {
+	return CatchClause{n.ChildByFieldName("handler")}
+}
+
+// UnaryExpression wraps nodes of type unary_expression.
+type UnaryExpression struct{ treesitter.Node }
+
+// AsUnaryExpression converts n to UnaryExpression, reporting whether it is of type unary_expression.
+func AsUnaryExpression(n treesitter.Node) (UnaryExpression, bool) {
+	return UnaryExpression{n}, n.IsNamed() && n.Type() == "unary_expression"
+}
+
+// Argument returns the node in the argument field.
+func (n UnaryExpression) Argument() Expression {
+	return Expression{n.ChildByFieldName("argument")}
+}
+
+// Operator returns the node in the operator field.
+func (n UnaryExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// UpdateExpression wraps nodes of type update_expression.
+type UpdateExpression struct{ treesitter.Node }
+
+// AsUpdateExpression converts n to UpdateExpression, reporting whether it is of type update_expression.
+func AsUpdateExpression(n treesitter.Node) (UpdateExpression, bool) {
+	return UpdateExpression{n}, n.IsNamed() && n.Type() == "update_expression"
+}
+
+// Argument returns the node in the argument field.
+func (n UpdateExpression) Argument() Expression {
+	return Expression{n.ChildByFieldName("argument")}
+}
+
+// Operator returns the node in the operator field.
+func (n UpdateExpression) Operator() treesitter.Node {
+	return n.ChildByFieldName("operator")
+}
+
+// VariableDeclaration wraps nodes of type variable_declaration.
+type VariableDeclaration struct{ treesitter.Node }
+
+// AsVariableDeclaration converts n to VariableDeclaration, reporting whether it is of type variable_declaration.
+func AsVariableDeclaration(n treesitter.Node) (VariableDeclaration, bool) {
+	return VariableDeclaration{n}, n.IsNamed() && n.Type() == "variable_declaration"
+}
+
+// Contents returns the named children of the node that aren't in a field.
+func (n VariableDeclaration) Contents() []VariableDeclarator {
+	var nodes []VariableDeclarator
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			nodes = append(nodes, VariableDeclarator{c})
+		}
+	}
+	return nodes
+}
+
+// VariableDeclarator wraps nodes of type variable_declarator.
+type VariableDeclarator struct{ treesitter.Node }
+
+// AsVariableDeclarator converts n to VariableDeclarator, reporting whether it is of type variable_declarator.
+func AsVariableDeclarator(n treesitter.Node) (VariableDeclarator, bool) {
+	return VariableDeclarator{n}, n.IsNamed() && n.Type() == "variable_declarator"
+}
+
+// Name returns the node in the name field.
+func (n VariableDeclarator) Name() treesitter.Node {
+	return n.ChildByFieldName("name")
+}
+
+// Value returns the node in the value field.
+func (n VariableDeclarator) Value() Expression {
+	return Expression{n.ChildByFieldName("value")}
+}
+
+// WhileStatement wraps nodes of type while_statement.
+type WhileStatement struct{ treesitter.Node }
+
+// AsWhileStatement converts n to WhileStatement, reporting whether it is of type while_statement.
+func AsWhileStatement(n treesitter.Node) (WhileStatement, bool) {
+	return WhileStatement{n}, n.IsNamed() && n.Type() == "while_statement"
+}
+
+// Body returns the node in the body field.
+func (n WhileStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Condition returns the node in the condition field.
+func (n WhileStatement) Condition() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("condition")}
+}
+
+// WithStatement wraps nodes of type with_statement.
+type WithStatement struct{ treesitter.Node }
+
+// AsWithStatement converts n to WithStatement, reporting whether it is of type with_statement.
+func AsWithStatement(n treesitter.Node) (WithStatement, bool) {
+	return WithStatement{n}, n.IsNamed() && n.Type() == "with_statement"
+}
+
+// Body returns the node in the body field.
+func (n WithStatement) Body() Statement {
+	return Statement{n.ChildByFieldName("body")}
+}
+
+// Object returns the node in the object field.
+func (n WithStatement) Object() ParenthesizedExpression {
+	return ParenthesizedExpression{n.ChildByFieldName("object")}
+}
+
+// YieldExpression wraps nodes of type yield_expression.
+type YieldExpression struct{ treesitter.Node }
+
+// AsYieldExpression converts n to YieldExpression, reporting whether it is of type yield_expression.
+func AsYieldExpression(n treesitter.Node) (YieldExpression, bool) {
+	return YieldExpression{n}, n.IsNamed() && n.Type() == "yield_expression"
+}
+
+// Content returns the named child of the node that isn't in a field.
+func (n YieldExpression) Content() Expression {
+	for i, c := range n.Children() {
+		if c.IsNamed() && !c.IsExtra() && n.FieldNameForChild(i) == "" {
+			return Expression{c}
+		}
+	}
+	return Expression{}
+}
+
+// Comment wraps nodes of type comment.
+type Comment struct{ treesitter.Node }
+
+// AsComment converts n to Comment, reporting whether it is of type comment.
+func AsComment(n treesitter.Node) (Comment, bool) {
+	return Comment{n}, n.IsNamed() && n.Type() == "comment"
+}
+
+// EscapeSequence wraps nodes of type escape_sequence.
+type EscapeSequence struct{ treesitter.Node }
+
+// AsEscapeSequence converts n to EscapeSequence, reporting whether it is of type escape_sequence.
+func AsEscapeSequence(n treesitter.Node) (EscapeSequence, bool) {
+	return EscapeSequence{n}, n.IsNamed() && n.Type() == "escape_sequence"
+}
+
+// False wraps nodes of type false.
+type False struct{ treesitter.Node }
+
+// AsFalse converts n to False, reporting whether it is of type false.
+func AsFalse(n treesitter.Node) (False, bool) {
+	return False{n}, n.IsNamed() && n.Type() == "false"
+}
+
+// GlimmerClosingTag wraps nodes of type glimmer_closing_tag.
+type GlimmerClosingTag struct{ treesitter.Node }
+
+// AsGlimmerClosingTag converts n to GlimmerClosingTag, reporting whether it is of type glimmer_closing_tag.
+func AsGlimmerClosingTag(n treesitter.Node) (GlimmerClosingTag, bool) {
+	return GlimmerClosingTag{n}, n.IsNamed() && n.Type() == "glimmer_closing_tag"
+}
+
+// GlimmerOpeningTag wraps nodes of type glimmer_opening_tag.
+type GlimmerOpeningTag struct{ treesitter.Node }
+
+// AsGlimmerOpeningTag converts n to GlimmerOpeningTag, reporting whether it is of type glimmer_opening_tag.
+func AsGlimmerOpeningTag(n treesitter.Node) (GlimmerOpeningTag, bool) {
+	return GlimmerOpeningTag{n}, n.IsNamed() && n.Type() == "glimmer_opening_tag"
+}
+
+// HashBangLine wraps nodes of type hash_bang_line.
+type HashBangLine struct{ treesitter.Node }
+
+// AsHashBangLine converts n to HashBangLine, reporting whether it is of type hash_bang_line.
+func AsHashBangLine(n treesitter.Node) (HashBangLine, bool) {
+	return HashBangLine{n}, n.IsNamed() && n.Type() == "hash_bang_line"
+}
+
+// HtmlCharacterReference wraps nodes of type html_character_reference.
+type HtmlCharacterReference struct{ treesitter.Node }
+
+// AsHtmlCharacterReference converts n to HtmlCharacterReference, reporting whether it is of type html_character_reference.
+func AsHtmlCharacterReference(n treesitter.Node) (HtmlCharacterReference, bool) {
+	return HtmlCharacterReference{n}, n.IsNamed() && n.Type() == "html_character_reference"
+}
+
+// HtmlComment wraps nodes of type html_comment.
+type HtmlComment struct{ treesitter.Node }
+
+// AsHtmlComment converts n to HtmlComment, reporting whether it is of type html_comment.
+func AsHtmlComment(n treesitter.Node) (HtmlComment, bool) {
+	return HtmlComment{n}, n.IsNamed() && n.Type() == "html_comment"
+}
+
+// Identifier wraps nodes of type identifier.
+type Identifier struct{ treesitter.Node }
+
+// AsIdentifier converts n to Identifier, reporting whether it is of type identifier.
+func AsIdentifier(n treesitter.Node) (Identifier, bool) {
+	return Identifier{n}, n.IsNamed() && n.Type() == "identifier"
+}
+
+// Null wraps nodes of type null.
+type Null struct{ treesitter.Node }
+
+// AsNull converts n to Null, reporting whether it is of type null.
+func AsNull(n treesitter.Node) (Null, bool) {
+	return Null{n}, n.IsNamed() && n.Type() == "null"
+}
+
+// Number wraps nodes of type number.
+type Number struct{ treesitter.Node }
+
+// AsNumber converts n to Number, reporting whether it is of type number.
+func AsNumber(n treesitter.Node) (Number, bool) {
+	return Number{n}, n.IsNamed() && n.Type() == "number"
+}
+
+// OptionalChain wraps nodes of type optional_chain.
+type OptionalChain struct{ treesitter.Node }
+
+// AsOptionalChain converts n to OptionalChain, reporting whether it is of type optional_chain.
+func AsOptionalChain(n treesitter.Node) (OptionalChain, bool) {
+	return OptionalChain{n}, n.IsNamed() && n.Type() == "optional_chain"
+}
+
+// PrivatePropertyIdentifier wraps nodes of type private_property_identifier.
+type PrivatePropertyIdentifier struct{ treesitter.Node }
+
+// AsPrivatePropertyIdentifier converts n to PrivatePropertyIdentifier, reporting whether it is of type private_property_identifier.
+func AsPrivatePropertyIdentifier(n treesitter.Node) (PrivatePropertyIdentifier, bool) {
+	return PrivatePropertyIdentifier{n}, n.IsNamed() && n.Type() == "private_property_identifier"
+}
+
+// PropertyIdentifier wraps nodes of type property_identifier.
+type PropertyIdentifier struct{ treesitter.Node }
+
+// AsPropertyIdentifier converts n to PropertyIdentifier, reporting whether it is of type property_identifier.
+func AsPropertyIdentifier(n treesitter.Node) (PropertyIdentifier, bool) {
+	return PropertyIdentifier{n}, n.IsNamed() && n.Type() == "property_identifier"
+}
+
+// RegexFlags wraps nodes of type regex_flags.
+type RegexFlags struct{ treesitter.Node }
+
+// AsRegexFlags converts n to RegexFlags, reporting whether it is of type regex_flags.
+func AsRegexFlags(n treesitter.Node) (RegexFlags, bool) {
+	return RegexFlags{n}, n.IsNamed() && n.Type() == "regex_flags"
+}
+
+// RegexPattern wraps nodes of type regex_pattern.
+type RegexPattern struct{ treesitter.Node }
+
+// AsRegexPattern converts n to RegexPattern, reporting whether it is of type regex_pattern.
+func AsRegexPattern(n treesitter.Node) (RegexPattern, bool) {
+	return RegexPattern{n}, n.IsNamed() && n.Type() == "regex_pattern"
+}
+
+// ShorthandPropertyIdentifier wraps nodes of type shorthand_property_identifier.
+type ShorthandPropertyIdentifier struct{ treesitter.Node }
+
+// AsShorthandPropertyIdentifier converts n to ShorthandPropertyIdentifier, reporting whether it is of type shorthand_property_identifier.
+func AsShorthandPropertyIdentifier(n treesitter.Node) (ShorthandPropertyIdentifier, bool) {
+	return ShorthandPropertyIdentifier{n}, n.IsNamed() && n.Type() == "shorthand_property_identifier"
+}
+
+// ShorthandPropertyIdentifierPattern wraps nodes of type shorthand_property_identifier_pattern.
+type ShorthandPropertyIdentifierPattern struct{ treesitter.Node }
+
+// AsShorthandPropertyIdentifierPattern converts n to ShorthandPropertyIdentifierPattern, reporting whether it is of type shorthand_property_identifier_pattern.
+func AsShorthandPropertyIdentifierPattern(n treesitter.Node) (ShorthandPropertyIdentifierPattern, bool) {
+	return ShorthandPropertyIdentifierPattern{n}, n.IsNamed() && n.Type() == "shorthand_property_identifier_pattern"
+}
+
+// StatementIdentifier wraps nodes of type statement_identifier.
+type StatementIdentifier struct{ treesitter.Node }
+
+// AsStatementIdentifier converts n to StatementIdentifier, reporting whether it is of type statement_identifier.
+func AsStatementIdentifier(n treesitter.Node) (StatementIdentifier, bool) {
+	return StatementIdentifier{n}, n.IsNamed() && n.Type() == "statement_identifier"
+}
+
+// StringFragment wraps nodes of type string_fragment.
+type StringFragment struct{ treesitter.Node }
+
+// AsStringFragment converts n to StringFragment, reporting whether it is of type string_fragment.
+func AsStringFragment(n treesitter.Node) (StringFragment, bool) {
+	return StringFragment{n}, n.IsNamed() && n.Type() == "string_fragment"
+}
+
+// Super wraps nodes of type super.
+type Super struct{ treesitter.Node }
+
+// AsSuper converts n to Super, reporting whether it is of type super.
+func AsSuper(n treesitter.Node) (Super, bool) {
+	return Super{n}, n.IsNamed() && n.Type() == "super"
+}
+
+// This wraps nodes of type this.
+type This struct{ treesitter.Node }
+
+// AsThis converts n to This, reporting whether it is of type this.
+func AsThis(n treesitter.Node) (This, bool) {
+	return This{n}, n.IsNamed() && n.Type() == "this"
+}
+
+// True wraps nodes of type true.
+type True struct{ treesitter.Node }
+
+// AsTrue converts n to True, reporting whether it is of type true.
+func AsTrue(n treesitter.Node) (True, bool) {
+	return True{n}, n.IsNamed() && n.Type() == "true"
+}
+
+// Undefined wraps nodes of type undefined.
+type Undefined struct{ treesitter.Node }
+
+// AsUndefined converts n to Undefined, reporting whether it is of type undefined.
+func AsUndefined(n treesitter.Node) (Undefined, bool) {
+	return Undefined{n}, n.IsNamed() && n.Type() == "undefined"
+}
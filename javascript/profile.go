@@ -0,0 +1,32 @@
+package javascript
+
+import "github.com/boldsoftware/treesitter"
+
+func init() {
+	treesitter.RegisterProfile("javascript", &treesitter.Profile{
+		Declarations: []string{
+			"function_declaration",
+			"generator_function_declaration",
+			"class_declaration",
+			"method_definition",
+			"lexical_declaration",
+			"variable_declaration",
+		},
+		Containers: []string{
+			"class_body",
+			"export_statement",
+		},
+		Statements: []string{
+			"expression_statement",
+			"return_statement",
+			"throw_statement",
+			"if_statement",
+			"for_statement",
+			"for_in_statement",
+			"while_statement",
+			"do_statement",
+			"switch_statement",
+			"try_statement",
+		},
+	})
+}
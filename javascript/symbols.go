@@ -0,0 +1,167 @@
+// Code generated by tsgen. DO NOT EDIT.
+
+package javascript
+
+import "github.com/boldsoftware/treesitter"
+
+// Symbols of the named node types, as returned by treesitter.Node.Symbol.
+const (
+	SymIdentifier                         treesitter.Symbol = 1   // identifier
+	SymHashBangLine                       treesitter.Symbol = 2   // hash_bang_line
+	SymGlimmerOpeningTag                  treesitter.Symbol = 43  // glimmer_opening_tag
+	SymGlimmerClosingTag                  treesitter.Symbol = 44  // glimmer_closing_tag
+	SymHtmlCharacterReference             treesitter.Symbol = 47  // html_character_reference
+	SymOptionalChain                      treesitter.Symbol = 63  // optional_chain
+	SymEscapeSequence                     treesitter.Symbol = 111 // escape_sequence
+	SymComment                            treesitter.Symbol = 112 // comment
+	SymRegexPattern                       treesitter.Symbol = 116 // regex_pattern
+	SymRegexFlags                         treesitter.Symbol = 117 // regex_flags
+	SymNumber                             treesitter.Symbol = 118 // number
+	SymPrivatePropertyIdentifier          treesitter.Symbol = 119 // private_property_identifier
+	SymThis                               treesitter.Symbol = 121 // this
+	SymSuper                              treesitter.Symbol = 122 // super
+	SymTrue                               treesitter.Symbol = 123 // true
+	SymFalse                              treesitter.Symbol = 124 // false
+	SymNull                               treesitter.Symbol = 125 // null
+	SymUndefined                          treesitter.Symbol = 126 // undefined
+	SymStringFragment                     treesitter.Symbol = 133 // string_fragment
+	SymHtmlComment                        treesitter.Symbol = 135 // html_comment
+	SymProgram                            treesitter.Symbol = 136 // program
+	SymExportStatement                    treesitter.Symbol = 137 // export_statement
+	SymNamespaceExport                    treesitter.Symbol = 138 // namespace_export
+	SymExportClause                       treesitter.Symbol = 139 // export_clause
+	SymExportSpecifier                    treesitter.Symbol = 140 // export_specifier
+	SymImport                             treesitter.Symbol = 143 // import
+	SymImportStatement                    treesitter.Symbol = 144 // import_statement
+	SymImportClause                       treesitter.Symbol = 145 // import_clause
+	SymNamespaceImport                    treesitter.Symbol = 147 // namespace_import
+	SymNamedImports                       treesitter.Symbol = 148 // named_imports
+	SymImportSpecifier                    treesitter.Symbol = 149 // import_specifier
+	SymImportAttribute                    treesitter.Symbol = 150 // import_attribute
+	SymExpressionStatement                treesitter.Symbol = 152 // expression_statement
+	SymVariableDeclaration                treesitter.Symbol = 153 // variable_declaration
+	SymLexicalDeclaration                 treesitter.Symbol = 154 // lexical_declaration
+	SymVariableDeclarator                 treesitter.Symbol = 155 // variable_declarator
+	SymStatementBlock                     treesitter.Symbol = 156 // statement_block
+	SymElseClause                         treesitter.Symbol = 157 // else_clause
+	SymIfStatement                        treesitter.Symbol = 158 // if_statement
+	SymSwitchStatement                    treesitter.Symbol = 159 // switch_statement
+	SymForStatement                       treesitter.Symbol = 160 // for_statement
+	SymForInStatement                     treesitter.Symbol = 161 // for_in_statement
+	SymWhileStatement                     treesitter.Symbol = 163 // while_statement
+	SymDoStatement                        treesitter.Symbol = 164 // do_statement
+	SymTryStatement                       treesitter.Symbol = 165 // try_statement
+	SymWithStatement                      treesitter.Symbol = 166 // with_statement
+	SymBreakStatement                     treesitter.Symbol = 167 // break_statement
+	SymContinueStatement                  treesitter.Symbol = 168 // continue_statement
+	SymDebuggerStatement                  treesitter.Symbol = 169 // debugger_statement
+	SymReturnStatement                    treesitter.Symbol = 170 // return_statement
+	SymThrowStatement                     treesitter.Symbol = 171 // throw_statement
+	SymEmptyStatement                     treesitter.Symbol = 172 // empty_statement
+	SymLabeledStatement                   treesitter.Symbol = 173 // labeled_statement
+	SymSwitchBody                         treesitter.Symbol = 174 // switch_body
+	SymSwitchCase                         treesitter.Symbol = 175 // switch_case
+	SymSwitchDefault                      treesitter.Symbol = 176 // switch_default
+	SymCatchClause                        treesitter.Symbol = 177 // catch_clause
+	SymFinallyClause                      treesitter.Symbol = 178 // finally_clause
+	SymParenthesizedExpression            treesitter.Symbol = 179 // parenthesized_expression
+	SymYieldExpression                    treesitter.Symbol = 182 // yield_expression
+	SymObject                             treesitter.Symbol = 183 // object
+	SymObjectPattern                      treesitter.Symbol = 184 // object_pattern
+	SymAssignmentPattern                  treesitter.Symbol = 185 // assignment_pattern
+	SymObjectAssignmentPattern            treesitter.Symbol = 186 // object_assignment_pattern
+	SymArray                              treesitter.Symbol = 187 // array
+	SymArrayPattern                       treesitter.Symbol = 188 // array_pattern
+	SymGlimmerTemplate                    treesitter.Symbol = 189 // glimmer_template
+	SymJsxElement                         treesitter.Symbol = 190 // jsx_element
+	SymJsxText                            treesitter.Symbol = 191 // jsx_text
+	SymJsxExpression                      treesitter.Symbol = 192 // jsx_expression
+	SymJsxOpeningElement                  treesitter.Symbol = 193 // jsx_opening_element
+	SymJsxNamespaceName                   treesitter.Symbol = 195 // jsx_namespace_name
+	SymJsxClosingElement                  treesitter.Symbol = 196 // jsx_closing_element
+	SymJsxSelfClosingElement              treesitter.Symbol = 197 // jsx_self_closing_element
+	SymJsxAttribute                       treesitter.Symbol = 198 // jsx_attribute
+	SymClass                              treesitter.Symbol = 200 // class
+	SymClassDeclaration                   treesitter.Symbol = 201 // class_declaration
+	SymClassHeritage                      treesitter.Symbol = 202 // class_heritage
+	SymFunctionExpression                 treesitter.Symbol = 203 // function_expression
+	SymFunctionDeclaration                treesitter.Symbol = 204 // function_declaration
+	SymGeneratorFunction                  treesitter.Symbol = 205 // generator_function
+	SymGeneratorFunctionDeclaration       treesitter.Symbol = 206 // generator_function_declaration
+	SymArrowFunction                      treesitter.Symbol = 207 // arrow_function
+	SymCallExpression                     treesitter.Symbol = 208 // call_expression
+	SymNewExpression                      treesitter.Symbol = 209 // new_expression
+	SymAwaitExpression                    treesitter.Symbol = 210 // await_expression
+	SymMemberExpression                   treesitter.Symbol = 211 // member_expression
+	SymSubscriptExpression                treesitter.Symbol = 212 // subscript_expression
+	SymAssignmentExpression               treesitter.Symbol = 213 // assignment_expression
+	SymAugmentedAssignmentExpression      treesitter.Symbol = 215 // augmented_assignment_expression
+	SymSpreadElement                      treesitter.Symbol = 218 // spread_element
+	SymTernaryExpression                  treesitter.Symbol = 219 // ternary_expression
+	SymBinaryExpression                   treesitter.Symbol = 220 // binary_expression
+	SymUnaryExpression                    treesitter.Symbol = 221 // unary_expression
+	SymUpdateExpression                   treesitter.Symbol = 222 // update_expression
+	SymSequenceExpression                 treesitter.Symbol = 223 // sequence_expression
+	SymString                             treesitter.Symbol = 224 // string
+	SymTemplateString                     treesitter.Symbol = 225 // template_string
+	SymTemplateSubstitution               treesitter.Symbol = 226 // template_substitution
+	SymRegex                              treesitter.Symbol = 227 // regex
+	SymMetaProperty                       treesitter.Symbol = 228 // meta_property
+	SymArguments                          treesitter.Symbol = 229 // arguments
+	SymDecorator                          treesitter.Symbol = 230 // decorator
+	SymClassBody                          treesitter.Symbol = 233 // class_body
+	SymFieldDefinition                    treesitter.Symbol = 234 // field_definition
+	SymFormalParameters                   treesitter.Symbol = 235 // formal_parameters
+	SymClassStaticBlock                   treesitter.Symbol = 236 // class_static_block
+	SymRestPattern                        treesitter.Symbol = 238 // rest_pattern
+	SymMethodDefinition                   treesitter.Symbol = 239 // method_definition
+	SymPair                               treesitter.Symbol = 240 // pair
+	SymPairPattern                        treesitter.Symbol = 241 // pair_pattern
+	SymComputedPropertyName               treesitter.Symbol = 243 // computed_property_name
+	SymPropertyIdentifier                 treesitter.Symbol = 265 // property_identifier
+	SymShorthandPropertyIdentifier        treesitter.Symbol = 266 // shorthand_property_identifier
+	SymShorthandPropertyIdentifierPattern treesitter.Symbol = 267 // shorthand_property_identifier_pattern
+	SymStatementIdentifier                treesitter.Symbol = 268 // statement_identifier
+)
+
+// Fields, as returned by treesitter.Node.FieldIDForChild.
+const (
+	FieldAlias         treesitter.FieldID = 1  // alias
+	FieldAlternative   treesitter.FieldID = 2  // alternative
+	FieldArgument      treesitter.FieldID = 3  // argument
+	FieldArguments     treesitter.FieldID = 4  // arguments
+	FieldAttribute     treesitter.FieldID = 5  // attribute
+	FieldBody          treesitter.FieldID = 6  // body
+	FieldCloseTag      treesitter.FieldID = 7  // close_tag
+	FieldCondition     treesitter.FieldID = 8  // condition
+	FieldConsequence   treesitter.FieldID = 9  // consequence
+	FieldConstructor   treesitter.FieldID = 10 // constructor
+	FieldContent       treesitter.FieldID = 11 // content
+	FieldDeclaration   treesitter.FieldID = 12 // declaration
+	FieldDecorator     treesitter.FieldID = 13 // decorator
+	FieldFinalizer     treesitter.FieldID = 14 // finalizer
+	FieldFlags         treesitter.FieldID = 15 // flags
+	FieldFunction      treesitter.FieldID = 16 // function
+	FieldHandler       treesitter.FieldID = 17 // handler
+	FieldIncrement     treesitter.FieldID = 18 // increment
+	FieldIndex         treesitter.FieldID = 19 // index
+	FieldInitializer   treesitter.FieldID = 20 // initializer
+	FieldKey           treesitter.FieldID = 21 // key
+	FieldKind          treesitter.FieldID = 22 // kind
+	FieldLabel         treesitter.FieldID = 23 // label
+	FieldLeft          treesitter.FieldID = 24 // left
+	FieldMember        treesitter.FieldID = 25 // member
+	FieldName          treesitter.FieldID = 26 // name
+	FieldObject        treesitter.FieldID = 27 // object
+	FieldOpenTag       treesitter.FieldID = 28 // open_tag
+	FieldOperator      treesitter.FieldID = 29 // operator
+	FieldOptionalChain treesitter.FieldID = 30 // optional_chain
+	FieldParameter     treesitter.FieldID = 31 // parameter
+	FieldParameters    treesitter.FieldID = 32 // parameters
+	FieldPattern       treesitter.FieldID = 33 // pattern
+	FieldProperty      treesitter.FieldID = 34 // property
+	FieldRight         treesitter.FieldID = 35 // right
+	FieldSource        treesitter.FieldID = 36 // source
+	FieldTemplate      treesitter.FieldID = 37 // template
+	FieldValue         treesitter.FieldID = 38 // value
+)
@@ -0,0 +1,77 @@
+package treesitter
+
+import "encoding/json"
+
+// JSONOptions controls the JSON encoding of nodes produced by Node.MarshalJSONOptions.
+type JSONOptions struct {
+	// MaxDepth limits how many levels of descendants are included; 0 means no limit.
+	MaxDepth int
+	// IncludeText adds the source text of every node, taken from Source,
+	// or from the source retained by ParseKeepSource if Source is nil.
+	IncludeText bool
+	Source      []byte
+}
+
+type jsonPoint struct {
+	Row    int `json:"row"`
+	Column int `json:"column"`
+}
+
+type jsonNode struct {
+	Type       string      `json:"type"`
+	Field      string      `json:"field,omitempty"`
+	StartByte  int         `json:"startByte"`
+	EndByte    int         `json:"endByte"`
+	StartPoint jsonPoint   `json:"startPoint"`
+	EndPoint   jsonPoint   `json:"endPoint"`
+	Text       string      `json:"text,omitempty"`
+	Children   []*jsonNode `json:"children,omitempty"`
+}
+
+// MarshalJSON encodes the node and all its descendants as a JSON document of the form
+//
+//	{"type": ..., "field": ..., "startByte": ..., "endByte": ...,
+//	 "startPoint": {"row": ..., "column": ...}, "endPoint": {...}, "children": [...]}
+func (n Node) MarshalJSON() ([]byte, error) {
+	return n.MarshalJSONOptions(JSONOptions{})
+}
+
+// MarshalJSONOptions is like MarshalJSON but allows limiting the depth and including source text.
+func (n Node) MarshalJSONOptions(opts JSONOptions) ([]byte, error) {
+	if n.IsNull() {
+		return []byte("null"), nil
+	}
+	c := NewTreeCursor(n)
+	defer c.Close()
+	return json.Marshal(buildJSONNode(c, opts, 0))
+}
+
+func buildJSONNode(c *TreeCursor, opts JSONOptions, depth int) *jsonNode {
+	n := c.CurrentNode()
+	jn := &jsonNode{
+		Type:       n.Type(),
+		Field:      c.CurrentFieldName(),
+		StartByte:  n.StartByte(),
+		EndByte:    n.EndByte(),
+		StartPoint: jsonPoint(n.StartPoint()),
+		EndPoint:   jsonPoint(n.EndPoint()),
+	}
+	if opts.IncludeText {
+		jn.Text = n.Text(opts.Source)
+	}
+	if opts.MaxDepth > 0 && depth >= opts.MaxDepth {
+		return jn
+	}
+	for ok := c.GoToFirstChild(); ok; ok = c.GoToNextSibling() {
+		jn.Children = append(jn.Children, buildJSONNode(c, opts, depth+1))
+	}
+	if jn.Children != nil {
+		c.GoToParent()
+	}
+	return jn
+}
+
+// MarshalJSON encodes the root node of the tree; see Node.MarshalJSON.
+func (t *Tree) MarshalJSON() ([]byte, error) {
+	return t.RootNode().MarshalJSON()
+}
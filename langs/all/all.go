@@ -0,0 +1,13 @@
+// Package all registers every grammar bundled with this module.
+//
+//	import _ "github.com/boldsoftware/treesitter/langs/all"
+//
+// registers "c", "go", "javascript" and "typescript".
+package all
+
+import (
+	_ "github.com/boldsoftware/treesitter/c"
+	_ "github.com/boldsoftware/treesitter/golang"
+	_ "github.com/boldsoftware/treesitter/javascript"
+	_ "github.com/boldsoftware/treesitter/typescript"
+)
@@ -0,0 +1,77 @@
+package all_test
+
+import (
+	"testing"
+
+	"github.com/boldsoftware/treesitter"
+	"github.com/boldsoftware/treesitter/c"
+	"github.com/boldsoftware/treesitter/golang"
+	"github.com/boldsoftware/treesitter/javascript"
+	_ "github.com/boldsoftware/treesitter/langs/all"
+	"github.com/boldsoftware/treesitter/typescript"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistered(t *testing.T) {
+	for lang, get := range map[string]func() *treesitter.Language{
+		"c":          c.GetLanguage,
+		"go":         golang.GetLanguage,
+		"javascript": javascript.GetLanguage,
+		"typescript": typescript.GetLanguage,
+	} {
+		_, err := treesitter.NewParserChecked(lang)
+		assert.NoError(t, err, lang)
+		registered, _ := treesitter.LookupLanguage(lang)
+		assert.Same(t, get(), registered, lang)
+	}
+	assert.Equal(t, []string{"c", "go", "javascript", "typescript"}, treesitter.Languages())
+}
+
+func TestAliases(t *testing.T) {
+	assert.Equal(t, []string{"c", "go", "javascript", "typescript"}, treesitter.Languages())
+	for alias, name := range map[string]string{"golang": "go", "js": "javascript", "ts": "typescript"} {
+		l, ok := treesitter.LookupLanguage(alias)
+		assert.True(t, ok, alias)
+		expected, _ := treesitter.LookupLanguage(name)
+		assert.Same(t, expected, l, alias)
+	}
+}
+
+func TestProfile(t *testing.T) {
+	for _, lang := range []string{"c", "go", "javascript", "typescript"} {
+		p, ok := treesitter.LanguageProfile(lang)
+		if !assert.True(t, ok, lang) {
+			continue
+		}
+		for _, types := range [][]string{p.Declarations, p.Containers, p.Statements} {
+			for _, typ := range types {
+				_, err := treesitter.NewQuery([]byte("("+typ+")"), lang)
+				assert.NoError(t, err, "%s: %s", lang, typ)
+			}
+		}
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	for filename, expected := range map[string]string{
+		"main.go":             "go",
+		"src/lib.c":           "c",
+		"include/lib.h":       "c",
+		"web/app.mjs":         "javascript",
+		"web/App.jsx":         "javascript",
+		"web/app.ts":          "typescript",
+		"web/app.tsx":         "", // needs the tsx grammar
+		"Dockerfile":          "",
+		"scripts/build.py":    "",
+		"no_extension":        "",
+		"archive.tar.gz":      "",
+		"MAIN.GO":             "go",
+		"/abs/path/to/file.c": "c",
+		"/abs/path/to/file.C": "", // C++
+		"include/lib.H":       "", // C++
+	} {
+		name, ok := treesitter.DetectLanguage(filename)
+		assert.Equal(t, expected != "", ok, filename)
+		assert.Equal(t, expected, name, filename)
+	}
+}
@@ -0,0 +1,11 @@
+// Package web registers the grammars used in web frontend codebases.
+//
+//	import _ "github.com/boldsoftware/treesitter/langs/web"
+//
+// registers "javascript" and "typescript".
+package web
+
+import (
+	_ "github.com/boldsoftware/treesitter/javascript"
+	_ "github.com/boldsoftware/treesitter/typescript"
+)
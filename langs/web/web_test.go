@@ -0,0 +1,16 @@
+package web_test
+
+import (
+	"testing"
+
+	"github.com/boldsoftware/treesitter"
+	_ "github.com/boldsoftware/treesitter/langs/web"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistered(t *testing.T) {
+	for _, lang := range []string{"javascript", "typescript"} {
+		_, err := treesitter.NewParserChecked(lang)
+		assert.NoError(t, err, lang)
+	}
+}
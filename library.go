@@ -0,0 +1,59 @@
+//go:build unix
+
+package treesitter
+
+// #cgo linux LDFLAGS: -ldl
+// #include <dlfcn.h>
+// #include <stdlib.h>
+// #include "api.h"
+//
+// typedef const TSLanguage *(*language_func)(void);
+//
+// static const TSLanguage *call_language_func(void *f) { return ((language_func)f)(); }
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// LoadLanguageFromLibrary loads a grammar compiled as a shared library, such as those built
+// by the tree-sitter CLI, and returns the language returned by its function symbol,
+// e.g. "tree_sitter_python".
+//
+// The library is never unloaded. The language isn't registered; see RegisterLanguage.
+// It returns an error wrapping ErrIncompatibleLanguage if the grammar was generated for
+// a version of tree-sitter this package doesn't support.
+func LoadLanguageFromLibrary(path, symbol string) (*Language, error) {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	handle := C.dlopen(cpath, C.RTLD_NOW|C.RTLD_LOCAL)
+	if handle == nil {
+		return nil, fmt.Errorf("loading %s: %w", path, dlerror())
+	}
+
+	csymbol := C.CString(symbol)
+	defer C.free(unsafe.Pointer(csymbol))
+	C.dlerror()
+	f := C.dlsym(handle, csymbol)
+	if f == nil {
+		err := dlerror()
+		C.dlclose(handle)
+		return nil, fmt.Errorf("loading %s from %s: %w", symbol, path, err)
+	}
+
+	ptr := C.call_language_func(f)
+	if err := checkLanguageVersion(unsafe.Pointer(ptr)); err != nil {
+		C.dlclose(handle)
+		return nil, fmt.Errorf("loading %s from %s: %w", symbol, path, err)
+	}
+	return NewLanguage(unsafe.Pointer(ptr)), nil
+}
+
+func dlerror() error {
+	if msg := C.dlerror(); msg != nil {
+		return errors.New(C.GoString(msg))
+	}
+	return errors.New("unknown error")
+}
@@ -0,0 +1,14 @@
+//go:build !unix
+
+package treesitter
+
+import (
+	"errors"
+	"runtime"
+)
+
+// LoadLanguageFromLibrary loads a grammar compiled as a shared library.
+// It is only supported on unix systems.
+func LoadLanguageFromLibrary(path, symbol string) (*Language, error) {
+	return nil, errors.New("loading grammar libraries is not supported on " + runtime.GOOS)
+}
@@ -0,0 +1,74 @@
+//go:build unix
+
+package treesitter
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// buildTestGrammarLibrary compiles the test grammar embedded in test_grammar.go as a shared library.
+func buildTestGrammarLibrary(t *testing.T) string {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler")
+	}
+
+	f, err := os.Open("test_grammar.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	// the C source is the cgo preamble, between the package clause and the import of "C"
+	var src strings.Builder
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() && !strings.HasPrefix(scanner.Text(), "package ") {
+	}
+	for scanner.Scan() {
+		line, ok := strings.CutPrefix(scanner.Text(), "//")
+		if !ok {
+			break
+		}
+		src.WriteString(line + "\n")
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "parser.c"), []byte(src.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	lib := filepath.Join(dir, "libtestgrammar.so")
+	out, err := exec.Command(cc, "-shared", "-fPIC", "-o", lib, filepath.Join(dir, "parser.c")).CombinedOutput()
+	if err != nil {
+		t.Fatalf("compiling the test grammar: %v\n%s", err, out)
+	}
+	return lib
+}
+
+func TestLoadLanguageFromLibrary(t *testing.T) {
+	assert := assert.New(t)
+	lib := buildTestGrammarLibrary(t)
+
+	lang, err := LoadLanguageFromLibrary(lib, "tree_sitter_test_grammar")
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal(languages["testlang"].SymbolCount(), lang.SymbolCount())
+
+	tree, err := NewParserFromLanguage(lang).Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+	assert.Equal("(expression (sum left: (expression (number)) right: (expression (number))))", tree.RootNode().String())
+
+	_, err = LoadLanguageFromLibrary(lib, "tree_sitter_missing")
+	assert.ErrorContains(err, "tree_sitter_missing")
+	_, err = LoadLanguageFromLibrary(filepath.Join(t.TempDir(), "missing.so"), "tree_sitter_test_grammar")
+	assert.Error(err)
+	assert.False(errors.Is(err, ErrIncompatibleLanguage))
+}
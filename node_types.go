@@ -0,0 +1,73 @@
+package treesitter
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NodeType describes a type of node of a language, as listed in the node-types.json file
+// generated along with its grammar.
+type NodeType struct {
+	Type  string `json:"type"`
+	Named bool   `json:"named"`
+	// Fields describes the children of the node in each of its fields.
+	Fields map[string]NodeChildren `json:"fields,omitempty"`
+	// Children describes the named children of the node that aren't in a field, if it has any.
+	Children *NodeChildren `json:"children,omitempty"`
+	// Subtypes lists the types a supertype stands for, such as the kinds of expressions.
+	// Supertypes are hidden rules, so no node has their type.
+	Subtypes []NodeTypeRef `json:"subtypes,omitempty"`
+}
+
+// IsSupertype reports whether the type is a supertype, standing for any of its subtypes.
+func (t *NodeType) IsSupertype() bool { return len(t.Subtypes) > 0 }
+
+// NodeTypeRef refers to a NodeType.
+type NodeTypeRef struct {
+	Type  string `json:"type"`
+	Named bool   `json:"named"`
+}
+
+// NodeChildren constrains the children of a node in a field, or outside of fields.
+type NodeChildren struct {
+	Multiple bool          `json:"multiple"` // there can be more than one
+	Required bool          `json:"required"` // there is at least one
+	Types    []NodeTypeRef `json:"types"`
+}
+
+// ParseNodeTypes decodes the contents of a node-types.json file.
+func ParseNodeTypes(data []byte) ([]NodeType, error) {
+	var types []NodeType
+	if err := json.Unmarshal(data, &types); err != nil {
+		return nil, fmt.Errorf("parsing node types: %w", err)
+	}
+	return types, nil
+}
+
+// SetNodeTypes sets the node types of the language from the contents of the node-types.json file
+// of its grammar. It is meant to be called on init, along with RegisterLanguage, by packages
+// embedding the file.
+func (l *Language) SetNodeTypes(data []byte) error {
+	types, err := ParseNodeTypes(data)
+	if err != nil {
+		return err
+	}
+	l.nodeTypes = types
+	return nil
+}
+
+// NodeTypes returns the node types of the language, or nil if they weren't set with SetNodeTypes.
+func (l *Language) NodeTypes() []NodeType {
+	return l.nodeTypes
+}
+
+// NodeType returns the description of the node type with the given name.
+// named selects between named node types and anonymous ones such as punctuation.
+func (l *Language) NodeType(typ string, named bool) (*NodeType, bool) {
+	for i := range l.nodeTypes {
+		if t := &l.nodeTypes[i]; t.Type == typ && t.Named == named {
+			return t, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,89 @@
+package treesitter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testNodeTypes is node-types.json as tree-sitter generates it for test_grammar.js.
+const testNodeTypes = `[
+  {
+    "type": "expression",
+    "named": true,
+    "subtypes": [
+      {"type": "number", "named": true},
+      {"type": "sum", "named": true},
+      {"type": "variable", "named": true}
+    ]
+  },
+  {
+    "type": "sum",
+    "named": true,
+    "fields": {
+      "left": {"multiple": false, "required": true, "types": [{"type": "expression", "named": true}]},
+      "right": {"multiple": false, "required": true, "types": [{"type": "expression", "named": true}]}
+    }
+  },
+  {"type": "(", "named": false},
+  {"type": ")", "named": false},
+  {"type": "+", "named": false},
+  {"type": "comment", "named": true},
+  {"type": "number", "named": true},
+  {"type": "variable", "named": true}
+]`
+
+func TestNodeTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	lang := NewLanguage(languages["testlang"].ptr)
+	assert.Nil(lang.NodeTypes())
+	_, ok := lang.NodeType("sum", true)
+	assert.False(ok)
+
+	assert.Error(lang.SetNodeTypes([]byte(`{"type": "sum"}`)))
+	assert.NoError(lang.SetNodeTypes([]byte(testNodeTypes)))
+	assert.Len(lang.NodeTypes(), 8)
+
+	expr, ok := lang.NodeType("expression", true)
+	assert.True(ok)
+	assert.True(expr.IsSupertype())
+	assert.Contains(expr.Subtypes, NodeTypeRef{Type: "sum", Named: true})
+
+	sum, ok := lang.NodeType("sum", true)
+	assert.True(ok)
+	assert.False(sum.IsSupertype())
+	assert.Nil(sum.Children)
+	assert.Equal(NodeChildren{Required: true, Types: []NodeTypeRef{{Type: "expression", Named: true}}}, sum.Fields["left"])
+
+	plus, ok := lang.NodeType("+", false)
+	assert.True(ok)
+	assert.Empty(plus.Fields)
+	_, ok = lang.NodeType("+", true)
+	assert.False(ok)
+
+	// every named type is one of the language's symbols
+	for _, typ := range lang.NodeTypes() {
+		_, ok := lang.SymbolForName(typ.Type, typ.Named)
+		assert.True(ok, typ.Type)
+	}
+}
+
+func TestSubtypes(t *testing.T) {
+	assert := assert.New(t)
+
+	lang := NewLanguage(languages["testlang"].ptr)
+	assert.Empty(lang.Supertypes())
+	expression, ok := lang.SymbolForName("expression", true)
+	assert.True(ok)
+	assert.Nil(lang.Subtypes(expression))
+
+	assert.NoError(lang.SetNodeTypes([]byte(testNodeTypes)))
+	var names []string
+	for _, s := range lang.Subtypes(expression) {
+		names = append(names, lang.SymbolName(s))
+	}
+	assert.Equal([]string{"number", "sum", "variable"}, names)
+	sum, _ := lang.SymbolForName("sum", true)
+	assert.Nil(lang.Subtypes(sum))
+}
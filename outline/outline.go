@@ -0,0 +1,189 @@
+// Package outline extracts the outline of a document, the hierarchy of the symbols it defines,
+// such as a class and its methods, from the definitions found by the bundled tags queries.
+//
+// Symbols marshal to JSON as the DocumentSymbol of the Language Server Protocol,
+// with positions counted in UTF-16 code units, so they can serve
+// textDocument/documentSymbol requests as they are.
+package outline
+
+import (
+	"bytes"
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/boldsoftware/treesitter"
+	"github.com/boldsoftware/treesitter/tags"
+)
+
+// SymbolKind is the kind of a symbol, with the values of the protocol.
+type SymbolKind int
+
+const (
+	KindFile SymbolKind = iota + 1
+	KindModule
+	KindNamespace
+	KindPackage
+	KindClass
+	KindMethod
+	KindProperty
+	KindField
+	KindConstructor
+	KindEnum
+	KindInterface
+	KindFunction
+	KindVariable
+	KindConstant
+	KindString
+	KindNumber
+	KindBoolean
+	KindArray
+	KindObject
+	KindKey
+	KindNull
+	KindEnumMember
+	KindStruct
+	KindEvent
+	KindOperator
+	KindTypeParameter
+)
+
+// kinds maps the kinds of definitions of tags queries to symbol kinds.
+var kinds = map[string]SymbolKind{
+	"class":       KindClass,
+	"constant":    KindConstant,
+	"constructor": KindConstructor,
+	"enum":        KindEnum,
+	"field":       KindField,
+	"function":    KindFunction,
+	"interface":   KindInterface,
+	"macro":       KindFunction,
+	"method":      KindMethod,
+	"module":      KindModule,
+	"namespace":   KindNamespace,
+	"property":    KindProperty,
+	"struct":      KindStruct,
+	"type":        KindClass,
+	"variable":    KindVariable,
+}
+
+// Symbol is a symbol defined in a document.
+type Symbol struct {
+	Name string `json:"name"`
+	// Detail is the start of the definition, such as the signature of a function.
+	Detail string     `json:"detail,omitempty"`
+	Kind   SymbolKind `json:"kind"`
+	// Range is the range of the whole definition.
+	Range treesitter.LSPRange `json:"range"`
+	// SelectionRange is the range of the name.
+	SelectionRange treesitter.LSPRange `json:"selectionRange"`
+	// Children are the symbols defined within the definition, such as the methods of a class.
+	Children []Symbol `json:"children,omitempty"`
+}
+
+// Extract returns the outline of tree, parsed from src as the given language, ordered by position.
+//
+// Local variables and constants, such as those of the body of a function, are left out.
+// With the profile of the language, they are those with nodes other than its declarations
+// and containers between them and the definition enclosing them; without one, those
+// directly within functions.
+func Extract(tree *treesitter.Tree, src []byte, lang string) ([]Symbol, error) {
+	t, err := tags.ForLanguage(lang)
+	if err != nil {
+		return nil, err
+	}
+	profile, hasProfile := treesitter.LanguageProfile(lang)
+	var defs []tags.Tag
+	for _, tag := range t.Tags(tree.RootNode(), src) {
+		if _, ok := kinds[tag.Kind]; ok && tag.IsDefinition {
+			defs = append(defs, tag)
+		}
+	}
+	// enclosing definitions first
+	slices.SortStableFunc(defs, func(a, b tags.Tag) int {
+		return cmp.Or(cmp.Compare(a.Range.StartByte, b.Range.StartByte), cmp.Compare(b.Range.EndByte, a.Range.EndByte))
+	})
+
+	type node struct {
+		Symbol
+		children []*node
+	}
+	root := &node{}
+	stack := []*node{root}
+	ranges := []treesitter.Range{{EndByte: len(src) + 1}}
+	for _, def := range defs {
+		for ranges[len(ranges)-1].EndByte < def.Range.EndByte || ranges[len(ranges)-1].EndByte <= def.Range.StartByte {
+			stack, ranges = stack[:len(stack)-1], ranges[:len(ranges)-1]
+		}
+		parent := stack[len(stack)-1]
+		kind := kinds[def.Kind]
+		if kind == KindVariable || kind == KindConstant {
+			if hasProfile && local(tree.RootNode(), def, ranges[len(ranges)-1], profile) ||
+				!hasProfile && (parent.Kind == KindFunction || parent.Kind == KindMethod) {
+				continue
+			}
+		}
+		n := &node{Symbol: Symbol{
+			Name:           def.Name,
+			Detail:         detail(def, src),
+			Kind:           kind,
+			Range:          lspRange(def.Range, src),
+			SelectionRange: lspRange(def.NameRange, src),
+		}}
+		parent.children = append(parent.children, n)
+		stack, ranges = append(stack, n), append(ranges, def.Range)
+	}
+
+	var symbols func(nodes []*node) []Symbol
+	symbols = func(nodes []*node) []Symbol {
+		if len(nodes) == 0 {
+			return nil
+		}
+		s := make([]Symbol, len(nodes))
+		for i, n := range nodes {
+			s[i] = n.Symbol
+			s[i].Children = symbols(n.children)
+		}
+		return s
+	}
+	return symbols(root.children), nil
+}
+
+// local reports whether def, within the definition spanning parent, is local: whether there
+// are nodes other than the declarations and containers of profile between them.
+func local(root treesitter.Node, def tags.Tag, parent treesitter.Range, profile *treesitter.Profile) bool {
+	n := root.NamedDescendantForByteRange(def.Range.StartByte, def.Range.EndByte)
+	for ; !n.Parent().IsNull(); n = n.Parent() {
+		if n.StartByte() <= parent.StartByte && n.EndByte() >= parent.EndByte {
+			break
+		}
+		if n.StartByte() == def.Range.StartByte && n.EndByte() == def.Range.EndByte {
+			continue
+		}
+		if !profile.IsDeclaration(n.Type()) && !profile.IsContainer(n.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// detail returns the start of the definition, up to the end of its first line or to its body.
+func detail(def tags.Tag, src []byte) string {
+	text := src[def.Range.StartByte:def.Range.EndByte]
+	if i := bytes.IndexAny(text, "{\n"); i >= 0 {
+		text = text[:i]
+	}
+	d := strings.TrimSpace(string(text))
+	if d == def.Name {
+		return ""
+	}
+	return d
+}
+
+func lspRange(r treesitter.Range, src []byte) treesitter.LSPRange {
+	return treesitter.LSPRange{Start: lspPosition(r.StartPoint, r.StartByte, src), End: lspPosition(r.EndPoint, r.EndByte, src)}
+}
+
+func lspPosition(p treesitter.Point, offset int, src []byte) treesitter.LSPPosition {
+	return treesitter.LSPPosition{Line: uint32(p.Row), Character: uint32(p.UTF16Column(src[offset-p.Column : offset]))}
+}
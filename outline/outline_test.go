@@ -0,0 +1,133 @@
+package outline_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/boldsoftware/treesitter"
+	_ "github.com/boldsoftware/treesitter/langs/all"
+	"github.com/boldsoftware/treesitter/outline"
+	"github.com/stretchr/testify/assert"
+)
+
+// symbol is the gist of an outline.Symbol.
+type symbol struct {
+	name     string
+	kind     outline.SymbolKind
+	detail   string
+	line     int
+	children []symbol
+}
+
+func extract(t *testing.T, src, language string) []outline.Symbol {
+	t.Helper()
+	tree, err := treesitter.NewParser(language).Parse(context.Background(), nil, []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	symbols, err := outline.Extract(tree, []byte(src), language)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return symbols
+}
+
+func gist(symbols []outline.Symbol) []symbol {
+	var g []symbol
+	for _, s := range symbols {
+		g = append(g, symbol{s.Name, s.Kind, s.Detail, int(s.SelectionRange.Start.Line), gist(s.Children)})
+	}
+	return g
+}
+
+func TestGo(t *testing.T) {
+	src := `package main
+
+const Answer = 42
+
+// T is a thing.
+type T struct{}
+
+func (t T) Run() error {
+	var x = 1
+	return nil
+}
+
+func main() {}
+
+var handler = func() {
+	const limit = 3
+}
+`
+	assert.Equal(t, []symbol{
+		{"main", outline.KindModule, "package main", 0, nil},
+		{"Answer", outline.KindConstant, "Answer = 42", 2, nil},
+		{"T", outline.KindClass, "T struct", 5, nil},
+		// without the local variable x
+		{"Run", outline.KindMethod, "func (t T) Run() error", 7, nil},
+		{"main", outline.KindFunction, "func main()", 12, nil},
+		// nor the constant of the function literal
+		{"handler", outline.KindVariable, "handler = func()", 14, nil},
+	}, gist(extract(t, src, "go")))
+}
+
+func TestJavascript(t *testing.T) {
+	src := `class A {
+  m() {}
+  n() {
+    function inner() {}
+  }
+}
+
+function f() {}
+`
+	assert.Equal(t, []symbol{
+		{"A", outline.KindClass, "class A", 0, []symbol{
+			{"m", outline.KindMethod, "m()", 1, nil},
+			{"n", outline.KindMethod, "n()", 2, []symbol{
+				{"inner", outline.KindFunction, "function inner()", 3, nil},
+			}},
+		}},
+		{"f", outline.KindFunction, "function f()", 7, nil},
+	}, gist(extract(t, src, "javascript")))
+}
+
+func TestRanges(t *testing.T) {
+	src := "package p\n\nvar s = \"🙂\"; func f() {\n}\n"
+	symbols := extract(t, src, "go")
+	if !assert.Len(t, symbols, 3) {
+		return
+	}
+	f := symbols[2]
+	// the emoji counts as two UTF-16 code units
+	assert.Equal(t, treesitter.LSPRange{
+		Start: treesitter.LSPPosition{Line: 2, Character: 14},
+		End:   treesitter.LSPPosition{Line: 3, Character: 1},
+	}, f.Range)
+	assert.Equal(t, treesitter.LSPRange{
+		Start: treesitter.LSPPosition{Line: 2, Character: 19},
+		End:   treesitter.LSPPosition{Line: 2, Character: 20},
+	}, f.SelectionRange)
+
+	b, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `{
+		"name": "f",
+		"detail": "func f()",
+		"kind": 12,
+		"range": {"start": {"line": 2, "character": 14}, "end": {"line": 3, "character": 1}},
+		"selectionRange": {"start": {"line": 2, "character": 19}, "end": {"line": 2, "character": 20}}
+	}`, string(b))
+}
+
+func TestUnknownLanguage(t *testing.T) {
+	tree, err := treesitter.NewParser("go").Parse(context.Background(), nil, []byte("package p\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = outline.Extract(tree, []byte("package p\n"), "cobol")
+	assert.Error(t, err)
+}
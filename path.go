@@ -0,0 +1,72 @@
+package treesitter
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PathStep is one step of a NodePath, leading from a node to one of its children.
+type PathStep struct {
+	Index int    // index of the child among all of its parent's children
+	Field string // field name of the child in its parent, or ""
+	Type  string // type of the child
+}
+
+// NodePath locates a node by the steps leading to it from the root of its tree.
+// Unlike a Node, it doesn't reference the tree, so it can be kept around and
+// resolved in another tree, for instance one reparsed after an edit.
+type NodePath []PathStep
+
+// String formats the path as slash-separated steps of the form "field:type[index]".
+func (p NodePath) String() string {
+	var b strings.Builder
+	for _, s := range p {
+		b.WriteByte('/')
+		if s.Field != "" {
+			b.WriteString(s.Field)
+			b.WriteByte(':')
+		}
+		b.WriteString(s.Type)
+		b.WriteByte('[')
+		b.WriteString(strconv.Itoa(s.Index))
+		b.WriteByte(']')
+	}
+	if b.Len() == 0 {
+		return "/"
+	}
+	return b.String()
+}
+
+// Path returns the path from the root of n's tree to n. The root's path is empty.
+func (n Node) Path() NodePath {
+	var path NodePath
+	for p := n.Parent(); !p.IsNull(); n, p = p, p.Parent() {
+		for i, c := range p.ChildNodes() {
+			if c.Equal(n) {
+				path = append(path, PathStep{Index: i, Field: p.FieldNameForChild(i), Type: n.Type()})
+				break
+			}
+		}
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// ResolvePath returns the node of the tree at path.
+// It returns false if at any step the child at the path's index
+// doesn't exist or doesn't have the expected type and field name.
+func (t *Tree) ResolvePath(path NodePath) (Node, bool) {
+	n := t.RootNode()
+	for _, s := range path {
+		if s.Index < 0 || s.Index >= n.ChildCount() {
+			return Node{}, false
+		}
+		c := n.Child(s.Index)
+		if c.Type() != s.Type || n.FieldNameForChild(s.Index) != s.Field {
+			return Node{}, false
+		}
+		n = c
+	}
+	return n, true
+}
@@ -3,6 +3,7 @@ package treesitter
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,6 +38,12 @@ func TestQueryWithPredicates(t *testing.T) {
 			msg:     "#match?: need a string as second argument",
 			pattern: `((expression) @capture
  (#match? @capture @capture))`,
+		},
+		{
+			success: false,
+			msg:     "#match?: need a valid regular expression",
+			pattern: `((expression) @capture
+ (#match? @capture "[a-"))`,
 		},
 		{
 			success: true,
@@ -230,6 +237,108 @@ func TestQueryWithPredicates(t *testing.T) {
 			pattern: `((expression) @capture
  (#set! "foo" "bar"))`,
 		},
+		{
+			success: true,
+			msg:     "#set!: success test without value",
+			pattern: `((expression) @capture
+ (#set! "foo"))`,
+		},
+		{
+			success: false,
+			msg:     "#any-of?: too few arguments",
+			pattern: `((expression) @capture
+ (#any-of? @capture))`,
+		},
+		{
+			success: false,
+			msg:     "#any-of?: need strings after the first argument",
+			pattern: `((expression) @capture
+ (#any-of? @capture "a" @capture))`,
+		},
+		{
+			success: true,
+			msg:     "#any-of?: success test",
+			pattern: `((expression) @capture
+ (#any-of? @capture "a" "b" "c"))`,
+		},
+		{
+			success: false,
+			msg:     "#not-any-of?: need a capture as first argument",
+			pattern: `((expression) @capture
+ (#not-any-of? "a" "b"))`,
+		},
+		{
+			success: false,
+			msg:     "#contains?: need a capture as first argument",
+			pattern: `((expression) @capture
+ (#contains? "a" "b"))`,
+		},
+		{
+			success: true,
+			msg:     "#contains?: success test",
+			pattern: `((expression) @capture
+ (#contains? @capture "a"))`,
+		},
+		{
+			success: false,
+			msg:     "#any-eq?: too many arguments",
+			pattern: `((expression) @capture
+ (#any-eq? @capture "a" "b"))`,
+		},
+		{
+			success: true,
+			msg:     "#any-eq?: success test",
+			pattern: `((expression) @capture
+ (#any-eq? @capture "a"))`,
+		},
+		{
+			success: false,
+			msg:     "#any-match?: need a string as second argument",
+			pattern: `((expression) @capture
+ (#any-match? @capture @capture))`,
+		},
+		{
+			success: false,
+			msg:     "#any-match?: need a valid regular expression",
+			pattern: `((expression) @capture
+ (#any-match? @capture "[a-"))`,
+		},
+		{
+			success: true,
+			msg:     "#any-not-match?: success test",
+			pattern: `((expression) @capture
+ (#any-not-match? @capture "^a"))`,
+		},
+		{
+			success: false,
+			msg:     "#offset!: too few arguments",
+			pattern: `((expression) @capture
+ (#offset! @capture 0 1 0))`,
+		},
+		{
+			success: false,
+			msg:     "#offset!: need integers after the first argument",
+			pattern: `((expression) @capture
+ (#offset! @capture 0 a 0 -1))`,
+		},
+		{
+			success: true,
+			msg:     "#offset!: success test",
+			pattern: `((expression) @capture
+ (#offset! @capture 0 1 0 -1))`,
+		},
+		{
+			success: false,
+			msg:     "#strip!: need a valid regular expression",
+			pattern: `((expression) @capture
+ (#strip! @capture "("))`,
+		},
+		{
+			success: true,
+			msg:     "#strip!: success test",
+			pattern: `((expression) @capture
+ (#strip! @capture "^//"))`,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -378,6 +487,219 @@ func TestFilterPredicates(t *testing.T) {
 			expectedBefore: 2,
 			expectedAfter:  0,
 		},
+		{
+			input: `// foo`,
+			query: `((comment) @capture
+  (#any-of? @capture "// bar" "// foo"))`,
+			expectedBefore: 1,
+			expectedAfter:  1,
+		},
+		{
+			input: `// foo`,
+			query: `((comment) @capture
+  (#any-of? @capture "// bar" "// baz"))`,
+			expectedBefore: 1,
+			expectedAfter:  0,
+		},
+		{
+			input: `// foo`,
+			query: `((comment) @capture
+  (#not-any-of? @capture "// bar" "// foo"))`,
+			expectedBefore: 1,
+			expectedAfter:  0,
+		},
+		{
+			input: `// foo`,
+			query: `((comment) @capture
+  (#not-any-of? @capture "// bar" "// baz"))`,
+			expectedBefore: 1,
+			expectedAfter:  1,
+		},
+		{
+			input: `// foo bar`,
+			query: `((comment) @capture
+  (#contains? @capture "baz" "bar"))`,
+			expectedBefore: 1,
+			expectedAfter:  1,
+		},
+		{
+			input: `// foo bar`,
+			query: `((comment) @capture
+  (#contains? @capture "baz"))`,
+			expectedBefore: 1,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum
+  left: (expression (number) @n)
+  right: (expression (number) @n))
+  (#any-eq? @n "2"))`,
+			expectedBefore: 2,
+			expectedAfter:  2,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum
+  left: (expression (number) @n)
+  right: (expression (number) @n))
+  (#any-eq? @n "3"))`,
+			expectedBefore: 2,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum
+  left: (expression (number) @n)
+  right: (expression (number) @n))
+  (#any-not-eq? @n "1"))`,
+			expectedBefore: 2,
+			expectedAfter:  2,
+		},
+		{
+			input: `1 + 1`,
+			query: `((sum
+  left: (expression (number) @n)
+  right: (expression (number) @n))
+  (#any-not-eq? @n "1"))`,
+			expectedBefore: 2,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum
+  left: (expression (number) @n)
+  right: (expression (number) @n))
+  (#any-match? @n "^2$"))`,
+			expectedBefore: 2,
+			expectedAfter:  2,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum
+  left: (expression (number) @n)
+  right: (expression (number) @n))
+  (#any-match? @n "^3"))`,
+			expectedBefore: 2,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 1`,
+			query: `((sum
+  left: (expression (number) @n)
+  right: (expression (number) @n))
+  (#any-not-match? @n "^1$"))`,
+			expectedBefore: 2,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 1`,
+			query: `((sum ((expression) @e "+"?)+)
+  (#eq? @e "1"))`,
+			expectedBefore: 2,
+			expectedAfter:  2,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum ((expression) @e "+"?)+)
+  (#eq? @e "1"))`,
+			expectedBefore: 2,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum ((expression) @e "+"?)+)
+  (#not-eq? @e "1"))`,
+			expectedBefore: 2,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum ((expression) @e "+"?)+)
+  (#any-not-eq? @e "1"))`,
+			expectedBefore: 2,
+			expectedAfter:  2,
+		},
+		{
+			input: `1 + 2`,
+			query: `((sum ((expression) @e "+"?)+)
+  (#not-match? @e "^2$"))`,
+			expectedBefore: 2,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 1 + 1`,
+			query: `((sum
+  left: (expression (sum left: (_) @l right: (_) @r))
+  right: (_) @l @r)
+  (#eq? @l @r))`,
+			expectedBefore: 4,
+			expectedAfter:  4,
+		},
+		{
+			input: `1 + 2 + 1`,
+			query: `((sum
+  left: (expression (sum left: (_) @l right: (_) @r))
+  right: (_) @l @r)
+  (#eq? @l @r))`,
+			expectedBefore: 4,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 2 + 1`,
+			query: `((sum
+  left: (expression (sum left: (_) @l right: (_) @r))
+  right: (_) @l @r)
+  (#any-eq? @l @r))`,
+			expectedBefore: 4,
+			expectedAfter:  4,
+		},
+		{
+			input: `1 + 1 + 2`,
+			query: `((sum
+  left: (expression (sum left: (_) @l right: (_) @r))
+  right: (_) @r)
+  (#eq? @l @r))`,
+			expectedBefore: 3,
+			expectedAfter:  0,
+		},
+		{
+			input: `1 + 1 + 2`,
+			query: `((sum
+  left: (expression (sum left: (_) @l right: (_) @r))
+  right: (_) @r)
+  (#any-eq? @l @r))`,
+			expectedBefore: 3,
+			expectedAfter:  3,
+		},
+		{
+			input: `1 + 2 + 2`,
+			query: `((sum
+  left: (expression (sum left: (_) @l right: (_) @r))
+  right: (_) @r)
+  (#any-eq? @l @r))`,
+			expectedBefore: 3,
+			expectedAfter:  0,
+		},
+		{
+			// no pair is equal, but both captures are exhausted together
+			input: `1 + 2 + 2`,
+			query: `((sum
+  left: (expression (sum left: (_) @l right: (_) @r))
+  right: (_))
+  (#any-eq? @l @r))`,
+			expectedBefore: 2,
+			expectedAfter:  2,
+		},
+		{
+			input: `1 + 1 + 2`,
+			query: `((sum
+  left: (expression (sum left: (_) @l right: (_) @r))
+  right: (_) @r)
+  (#any-not-eq? @l @r))`,
+			expectedBefore: 3,
+			expectedAfter:  0,
+		},
 	}
 
 	parser := NewParser("testlang")
@@ -401,3 +723,264 @@ func TestFilterPredicates(t *testing.T) {
 		assert.Len(t, after.Captures, testCase.expectedAfter, fmt.Sprintf("test num %d failed", testNum))
 	}
 }
+
+func TestDirectives(t *testing.T) {
+	assert := assert.New(t)
+
+	input := []byte("1 + 22\n// foo bar")
+	root, err := Parse(context.Background(), input, "testlang")
+	assert.NoError(err)
+
+	q, err := NewQuery([]byte(`((sum right: (expression (number) @n))
+  (#set! "kind" "addition")
+  (#set! "flag")
+  (#offset! @n 0 1 1 -1))
+((comment) @comment
+  (#strip! @comment "^//\\s*"))`), "testlang")
+	assert.NoError(err)
+
+	qc := NewQueryCursor()
+	qc.Exec(q, root)
+
+	m, ok := qc.NextMatch()
+	assert.True(ok)
+	m = qc.FilterPredicates(m, input)
+	assert.Equal(map[string]string{"kind": "addition", "flag": ""}, m.Properties)
+	assert.Equal(map[int]Range{
+		0: {
+			StartPoint: Point{Row: 0, Column: 5},
+			EndPoint:   Point{Row: 1, Column: 5},
+			StartByte:  5,
+			EndByte:    12,
+		},
+	}, m.CaptureRanges)
+	assert.Nil(m.CaptureTexts)
+
+	m, ok = qc.NextMatch()
+	assert.True(ok)
+	m = qc.FilterPredicates(m, input)
+	assert.Nil(m.Properties)
+	assert.Equal(map[int]string{0: "foo bar"}, m.CaptureTexts)
+}
+
+func TestOffsetDirective(t *testing.T) {
+	assert := assert.New(t)
+
+	input := []byte("12 +\n34 +\n\n5")
+	root, err := Parse(context.Background(), input, "testlang")
+	assert.NoError(err)
+
+	// each node of the capture is moved
+	q, err := NewQuery([]byte(`(sum
+  left: (expression (sum left: (expression (number) @n) right: (expression (number) @n)))
+  (#offset! @n 0 1 0 0))`), "testlang")
+	assert.NoError(err)
+	qc := NewQueryCursor()
+	qc.Exec(q, root)
+	m, ok := qc.NextMatch()
+	assert.True(ok)
+	m = qc.FilterPredicates(m, input)
+	assert.Equal(map[int]Range{
+		0: {StartPoint: Point{Row: 0, Column: 1}, EndPoint: Point{Row: 0, Column: 2}, StartByte: 1, EndByte: 2},
+		1: {StartPoint: Point{Row: 1, Column: 1}, EndPoint: Point{Row: 1, Column: 2}, StartByte: 6, EndByte: 7},
+	}, m.CaptureRanges)
+
+	// across rows from 34, clamped to the ends of rows and of the input
+	for _, tt := range []struct {
+		offsets string
+		r       Range
+	}{
+		{"-1 0 -1 0", Range{EndPoint: Point{Column: 2}, StartByte: 0, EndByte: 2}},
+		{"-3 1 -2 9", Range{StartPoint: Point{Column: 1}, EndPoint: Point{Column: 11}, StartByte: 1, EndByte: 4}},
+		{"1 0 1 0", Range{StartPoint: Point{Row: 2}, EndPoint: Point{Row: 2, Column: 2}, StartByte: 10, EndByte: 10}},
+		{"2 1 3 0", Range{StartPoint: Point{Row: 3, Column: 1}, EndPoint: Point{Row: 4, Column: 2}, StartByte: 12, EndByte: 12}},
+	} {
+		q, err := NewQuery([]byte(`(sum right: (expression (number) @n) (#offset! @n `+tt.offsets+`))`), "testlang")
+		assert.NoError(err)
+		qc.Exec(q, root)
+		m, ok := qc.NextMatch()
+		assert.True(ok)
+		m = qc.FilterPredicates(m, input)
+		assert.Equal(map[int]Range{0: tt.r}, m.CaptureRanges, tt.offsets)
+	}
+}
+
+func TestParsedPredicates(t *testing.T) {
+	assert := assert.New(t)
+
+	q, err := NewQuery([]byte(`((sum left: (_) @l right: (_) @r)
+  (#eq? @l @r)
+  (#any-of? @l "1" "2")
+  (#set! "kind"))
+(number) @n`), "testlang")
+	assert.NoError(err)
+
+	l := QueryPredicateArg{Type: QueryPredicateStepTypeCapture, ID: 0, Value: "l"}
+	r := QueryPredicateArg{Type: QueryPredicateStepTypeCapture, ID: 1, Value: "r"}
+	predicates := q.ParsedPredicates(0)
+	if assert.Len(predicates, 3) {
+		assert.Equal(QueryPredicate{Operator: "eq?", Args: []QueryPredicateArg{l, r}}, predicates[0])
+		assert.Equal("any-of?", predicates[1].Operator)
+		if assert.Len(predicates[1].Args, 3) {
+			assert.True(predicates[1].Args[0].IsCapture())
+			assert.False(predicates[1].Args[1].IsCapture())
+			assert.Equal("1", predicates[1].Args[1].Value)
+			assert.Equal("2", predicates[1].Args[2].Value)
+		}
+		assert.Equal("set!", predicates[2].Operator)
+		assert.Equal("kind", predicates[2].Args[0].Value)
+	}
+	assert.Empty(q.ParsedPredicates(1))
+}
+
+func TestAssertions(t *testing.T) {
+	assert := assert.New(t)
+
+	input := []byte("1 + 2")
+	root, err := Parse(context.Background(), input, "testlang")
+	assert.NoError(err)
+
+	q, err := NewQuery([]byte(`((number) @n (#is-not? local) (#is? "kind" "literal"))
+(sum) @sum`), "testlang")
+	assert.NoError(err)
+
+	expected := []QueryAssertion{
+		{Key: "local", Positive: false},
+		{Key: "kind", Value: "literal", Positive: true},
+	}
+	assert.Equal(expected, q.Assertions(0))
+	assert.Empty(q.Assertions(1))
+
+	var numbers int
+	for m := range NewQueryCursor().Matches(q, root, input) {
+		if m.PatternIndex == 0 {
+			numbers++
+			assert.Equal(expected, m.Assertions)
+		} else {
+			assert.Empty(m.Assertions)
+		}
+	}
+	assert.Equal(2, numbers)
+}
+
+func TestCustomPredicate(t *testing.T) {
+	assert := assert.New(t)
+
+	input := []byte("1 + 22 + 333")
+	root, err := Parse(context.Background(), input, "testlang")
+	assert.NoError(err)
+
+	q, err := NewQuery([]byte(`((number) @n (#longer-than? @n 1))`), "testlang")
+	assert.NoError(err)
+
+	var gotArgs []QueryPredicateArg
+	q.RegisterPredicate("longer-than?", func(args []QueryPredicateArg, m *QueryMatch, input []byte) bool {
+		gotArgs = args
+		n, _ := strconv.Atoi(args[1].Value)
+		for _, c := range m.Captures {
+			if c.Index == args[0].ID && len(c.Node.Bytes(input)) <= n {
+				return false
+			}
+		}
+		return true
+	})
+
+	qc := NewQueryCursor()
+	qc.Exec(q, root)
+	var numbers []string
+	for {
+		m, ok := qc.NextMatch()
+		if !ok {
+			break
+		}
+		for _, c := range qc.FilterPredicates(m, input).Captures {
+			numbers = append(numbers, c.Node.Text(input))
+		}
+	}
+	assert.Equal([]string{"22", "333"}, numbers)
+
+	for _, name := range []string{"eq?", "any-of?", "set!", "offset!"} {
+		assert.Panics(func() { q.RegisterPredicate(name, nil) }, name)
+	}
+	assert.Len(gotArgs, 2)
+	assert.Equal(QueryPredicateStepTypeCapture, gotArgs[0].Type)
+	assert.Equal("n", gotArgs[0].Value)
+	assert.Equal(QueryPredicateStepTypeString, gotArgs[1].Type)
+	assert.Equal("1", gotArgs[1].Value)
+}
+
+func TestQueryCursorMatches(t *testing.T) {
+	assert := assert.New(t)
+
+	input := []byte("1 + 2 + 1")
+	root, err := Parse(context.Background(), input, "testlang")
+	assert.NoError(err)
+
+	q, err := NewQuery([]byte(`((number) @one (#eq? @one "1")) (sum) @sum`), "testlang")
+	assert.NoError(err)
+
+	qc := NewQueryCursor()
+	var captured []string
+	for m := range qc.Matches(q, root, input) {
+		for _, c := range m.Captures {
+			captured = append(captured, q.CaptureNameForId(c.Index)+"="+c.Node.Text(input))
+		}
+	}
+	assert.Equal([]string{"sum=1 + 2 + 1", "sum=1 + 2", "one=1", "one=1"}, captured)
+
+	// stopping early
+	var n int
+	for range qc.Matches(q, root, input) {
+		n++
+		break
+	}
+	assert.Equal(1, n)
+}
+
+func TestQueryCursorCaptures(t *testing.T) {
+	assert := assert.New(t)
+
+	input := []byte("1 + 2 + 1")
+	root, err := Parse(context.Background(), input, "testlang")
+	assert.NoError(err)
+
+	q, err := NewQuery([]byte(`(sum right: (_) @right) ((number) @one (#eq? @one "1"))`), "testlang")
+	assert.NoError(err)
+
+	qc := NewQueryCursor()
+	var captured []string
+	for m, i := range qc.Captures(q, root, input) {
+		c := m.Captures[i]
+		captured = append(captured, fmt.Sprintf("%s=%s@%d", c.Name, c.Node.Text(input), c.Node.StartByte()))
+	}
+	// in source order, unlike the matches
+	assert.Equal([]string{"one=1@0", "right=2@4", "right=1@8", "one=1@8"}, captured)
+
+	// stopping early
+	var n int
+	for range qc.Captures(q, root, input) {
+		n++
+		break
+	}
+	assert.Equal(1, n)
+}
+
+func BenchmarkFilterPredicates(b *testing.B) {
+	input := []byte("1 + 2 + 3 + 4 + 5 + 6 + 7 + 8")
+	root, err := Parse(context.Background(), input, "testlang")
+	if err != nil {
+		b.Fatal(err)
+	}
+	q, err := NewQuery([]byte(`((number) @n (#match? @n "^[0-4]$"))`), "testlang")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	qc := NewQueryCursor()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for range qc.Matches(q, root, input) {
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package treesitter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PrettyOptions controls the output of Node.PrettyString.
+type PrettyOptions struct {
+	// Indent is repeated once per level of nesting; it defaults to two spaces.
+	Indent string
+	// NamedOnly omits anonymous nodes such as punctuation.
+	NamedOnly bool
+	// Positions adds the start and end (row:column) positions of each node.
+	Positions bool
+	// Snippets adds the quoted source text of each node, taken from Source,
+	// or from the source retained by ParseKeepSource if Source is nil.
+	// Snippets longer than MaxSnippet bytes (default 40) are truncated.
+	Snippets   bool
+	Source     []byte
+	MaxSnippet int
+}
+
+// PrettyString returns a multi-line, indented representation of the node and its descendants,
+// one node per line, prefixed by its field name if it has one.
+func (n Node) PrettyString(opts PrettyOptions) string {
+	if n.IsNull() {
+		return "(nil)"
+	}
+	if opts.Indent == "" {
+		opts.Indent = "  "
+	}
+	if opts.MaxSnippet <= 0 {
+		opts.MaxSnippet = 40
+	}
+
+	var sb strings.Builder
+	c := NewTreeCursor(n)
+	defer c.Close()
+	depth := 0
+	for {
+		cur := c.CurrentNode()
+		if !opts.NamedOnly || cur.IsNamed() || depth == 0 {
+			writePrettyNode(&sb, cur, c.CurrentFieldName(), depth, opts)
+		}
+		if c.GoToFirstChild() {
+			depth++
+			continue
+		}
+		for !c.GoToNextSibling() {
+			if !c.GoToParent() {
+				return sb.String()
+			}
+			depth--
+		}
+	}
+}
+
+func writePrettyNode(sb *strings.Builder, n Node, field string, depth int, opts PrettyOptions) {
+	if sb.Len() > 0 {
+		sb.WriteByte('\n')
+	}
+	sb.WriteString(strings.Repeat(opts.Indent, depth))
+	if field != "" {
+		sb.WriteString(field)
+		sb.WriteString(": ")
+	}
+	switch {
+	case n.IsMissing():
+		sb.WriteString("MISSING ")
+		sb.WriteString(n.Type())
+	case n.IsNamed():
+		sb.WriteString(n.Type())
+	default:
+		sb.WriteString(strconv.Quote(n.Type()))
+	}
+	if opts.Positions {
+		start, end := n.StartPoint(), n.EndPoint()
+		fmt.Fprintf(sb, " [%d:%d - %d:%d]", start.Row, start.Column, end.Row, end.Column)
+	}
+	if opts.Snippets {
+		text := n.Bytes(opts.Source)
+		if len(text) > opts.MaxSnippet {
+			sb.WriteByte(' ')
+			sb.WriteString(strconv.Quote(string(text[:opts.MaxSnippet])))
+			sb.WriteString("...")
+		} else if text != nil {
+			sb.WriteByte(' ')
+			sb.WriteString(strconv.Quote(string(text)))
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package treesitter
+
+import "slices"
+
+// Profile describes the node types of a language that play a structural role,
+// so that features such as outline can work the same way for every language.
+type Profile struct {
+	// Declarations introduce a named entity, such as a function, type or class.
+	Declarations []string
+	// Containers may hold further declarations, such as class bodies or namespaces.
+	Containers []string
+	// Statements are the smallest units below declarations worth handling on their own.
+	Statements []string
+}
+
+// IsDeclaration reports whether nodes of type typ are declarations.
+func (p *Profile) IsDeclaration(typ string) bool { return slices.Contains(p.Declarations, typ) }
+
+// IsContainer reports whether nodes of type typ are containers.
+func (p *Profile) IsContainer(typ string) bool { return slices.Contains(p.Containers, typ) }
+
+// IsStatement reports whether nodes of type typ are statements.
+func (p *Profile) IsStatement(typ string) bool { return slices.Contains(p.Statements, typ) }
+
+var profiles = map[string]*Profile{}
+
+// RegisterProfile registers the profile of a language.
+// Like RegisterLanguage, it is called on init from packages that contain a language parser.
+func RegisterProfile(langName string, p *Profile) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if profiles[langName] != nil {
+		panic("profile for language " + langName + " already registered")
+	}
+	profiles[langName] = p
+}
+
+// LanguageProfile returns the profile registered for a language, by name or alias.
+func LanguageProfile(langName string) (*Profile, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	p, ok := profiles[resolveAlias(langName)]
+	return p, ok
+}
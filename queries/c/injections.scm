@@ -0,0 +1,5 @@
+((comment) @injection.content
+  (#set! injection.language "comment"))
+
+((preproc_arg) @injection.content
+  (#set! injection.language "c"))
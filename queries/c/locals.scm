@@ -0,0 +1,31 @@
+; Scopes
+
+[
+  (translation_unit)
+  (function_definition)
+  (compound_statement)
+  (for_statement)
+] @local.scope
+
+; Definitions
+
+(parameter_declaration
+  declarator: (identifier) @local.definition)
+
+(parameter_declaration
+  declarator: (pointer_declarator
+    declarator: (identifier) @local.definition))
+
+(declaration
+  declarator: (identifier) @local.definition)
+
+(init_declarator
+  declarator: (identifier) @local.definition)
+
+(init_declarator
+  declarator: (pointer_declarator
+    declarator: (identifier) @local.definition))
+
+; References
+
+(identifier) @local.reference
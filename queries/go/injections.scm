@@ -0,0 +1,15 @@
+((comment) @injection.content
+  (#set! injection.language "comment"))
+
+((call_expression
+  function: (selector_expression) @_function
+  arguments: (argument_list
+    .
+    [
+      (raw_string_literal)
+      (interpreted_string_literal)
+    ] @injection.content))
+  (#any-of? @_function
+    "regexp.Compile" "regexp.CompilePOSIX" "regexp.Match" "regexp.MatchReader"
+    "regexp.MatchString" "regexp.MustCompile" "regexp.MustCompilePOSIX")
+  (#set! injection.language "regex"))
@@ -0,0 +1,15 @@
+((comment) @injection.content
+  (#set! injection.language "comment"))
+
+((comment) @injection.content
+  (#match? @injection.content "^/\\*\\*")
+  (#set! injection.language "jsdoc"))
+
+((regex_pattern) @injection.content
+  (#set! injection.language "regex"))
+
+((call_expression
+  function: (identifier) @_name
+  arguments: (template_string) @injection.content)
+  (#any-of? @_name "html" "css" "sql" "graphql" "gql")
+  (#set! injection.language "html"))
@@ -0,0 +1,28 @@
+; Scopes
+
+[
+  (statement_block)
+  (function_expression)
+  (arrow_function)
+  (function_declaration)
+  (method_definition)
+] @local.scope
+
+; Definitions
+
+(formal_parameters
+  (identifier) @local.definition)
+
+(formal_parameters
+  (assignment_pattern
+    left: (identifier) @local.definition))
+
+(arrow_function
+  parameter: (identifier) @local.definition)
+
+(variable_declarator
+  name: (identifier) @local.definition)
+
+; References
+
+(identifier) @local.reference
@@ -0,0 +1,92 @@
+// Package queries embeds the standard query files of the bundled grammars:
+// highlights.scm, injections.scm, locals.scm and tags.scm.
+//
+// The queries are compiled for the language registered under the same name, so the
+// grammar package must be imported as well, e.g.
+//
+//	import _ "github.com/boldsoftware/treesitter/golang"
+//
+//	q, err := queries.Highlights("go")
+package queries
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+
+	"github.com/boldsoftware/treesitter"
+)
+
+//go:embed */*.scm
+var files embed.FS
+
+// Kinds of standard query files.
+const (
+	KindHighlights = "highlights"
+	KindInjections = "injections"
+	KindLocals     = "locals"
+	KindTags       = "tags"
+)
+
+// ErrNoQuery is returned for a language or kind of query that isn't bundled.
+var ErrNoQuery = errors.New("no bundled query")
+
+// inherits lists, by language and kind, the languages whose queries are prepended
+// to the language's own, as typescript's only cover what it adds to javascript.
+// Its locals are complete instead, since javascript's parameters don't parse the same.
+var inherits = map[string]map[string][]string{
+	"typescript": {
+		KindHighlights: {"javascript"},
+		KindInjections: {"javascript"},
+		KindTags:       {"javascript"},
+	},
+}
+
+// Source returns the text of the query of the given kind for language,
+// including the queries it inherits from other languages.
+func Source(language, kind string) ([]byte, error) {
+	var src []byte
+	for _, lang := range append(slices.Clone(inherits[language][kind]), language) {
+		b, err := files.ReadFile(path.Join(lang, kind+".scm"))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		src = append(src, b...)
+		src = append(src, '\n')
+	}
+	if src == nil {
+		return nil, fmt.Errorf("%w: %s for %s", ErrNoQuery, kind, language)
+	}
+	return src, nil
+}
+
+// Load returns the compiled query of the given kind for language.
+//
+// Queries are compiled once and cached in treesitter.DefaultQueryCache, so
+// they are shared and must not be closed or modified. To register custom predicates
+// with Query.RegisterPredicate, compile a query of its own from Source with treesitter.NewQuery.
+func Load(language, kind string) (*treesitter.Query, error) {
+	src, err := Source(language, kind)
+	if err != nil {
+		return nil, err
+	}
+	return treesitter.DefaultQueryCache.GetOrCompile(src, language)
+}
+
+// Highlights returns the syntax highlighting query for language.
+func Highlights(language string) (*treesitter.Query, error) { return Load(language, KindHighlights) }
+
+// Injections returns the query finding the ranges of language written in other languages.
+func Injections(language string) (*treesitter.Query, error) { return Load(language, KindInjections) }
+
+// Locals returns the query finding the scopes, definitions and references of local variables.
+func Locals(language string) (*treesitter.Query, error) { return Load(language, KindLocals) }
+
+// Tags returns the query finding definitions of and references to named entities.
+func Tags(language string) (*treesitter.Query, error) { return Load(language, KindTags) }
@@ -0,0 +1,73 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/boldsoftware/treesitter"
+	_ "github.com/boldsoftware/treesitter/langs/all"
+	"github.com/boldsoftware/treesitter/queries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	kinds := []string{queries.KindHighlights, queries.KindInjections, queries.KindLocals, queries.KindTags}
+	for _, lang := range []string{"c", "go", "javascript", "typescript"} {
+		for _, kind := range kinds {
+			q, err := queries.Load(lang, kind)
+			if assert.NoError(t, err, "%s/%s", lang, kind) {
+				assert.NotZero(t, q.PatternCount(), "%s/%s", lang, kind)
+			}
+		}
+	}
+
+	_, err := queries.Highlights("cobol")
+	assert.ErrorIs(t, err, queries.ErrNoQuery)
+	_, err = queries.Load("go", "folds")
+	assert.ErrorIs(t, err, queries.ErrNoQuery)
+}
+
+func TestHighlights(t *testing.T) {
+	assert := assert.New(t)
+
+	src := []byte("package main\n\nfunc run() { println(\"hi\") }\n")
+	root, err := treesitter.Parse(context.Background(), src, "go")
+	assert.NoError(err)
+
+	q, err := queries.Highlights("go")
+	assert.NoError(err)
+	again, err := queries.Highlights("go")
+	assert.NoError(err)
+	assert.Same(q, again)
+
+	captures := map[string]string{}
+	for m := range treesitter.NewQueryCursor().Matches(q, root, src) {
+		for _, c := range m.Captures {
+			// highlighters use the first pattern matching a node
+			if _, ok := captures[c.Node.Text(src)]; !ok {
+				captures[c.Node.Text(src)] = c.Name
+			}
+		}
+	}
+	assert.Equal("keyword", captures["package"])
+	assert.Equal("function", captures["run"])
+	assert.Equal("function.builtin", captures["println"])
+	assert.Equal("string", captures[`"hi"`])
+}
+
+func TestTypescriptInheritsJavascript(t *testing.T) {
+	assert := assert.New(t)
+
+	js, err := queries.Source("javascript", queries.KindHighlights)
+	assert.NoError(err)
+	ts, err := queries.Source("typescript", queries.KindHighlights)
+	assert.NoError(err)
+	assert.Equal(js, ts[:len(js)])
+
+	// typescript has no injections of its own
+	jsInj, err := queries.Source("javascript", queries.KindInjections)
+	assert.NoError(err)
+	tsInj, err := queries.Source("typescript", queries.KindInjections)
+	assert.NoError(err)
+	assert.Equal(jsInj, tsInj)
+}
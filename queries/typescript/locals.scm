@@ -0,0 +1,24 @@
+; Scopes
+
+[
+  (statement_block)
+  (function_expression)
+  (arrow_function)
+  (function_declaration)
+  (method_definition)
+] @local.scope
+
+; Definitions
+
+(required_parameter (identifier) @local.definition)
+(optional_parameter (identifier) @local.definition)
+
+(arrow_function
+  parameter: (identifier) @local.definition)
+
+(variable_declarator
+  name: (identifier) @local.definition)
+
+; References
+
+(identifier) @local.reference
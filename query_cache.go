@@ -0,0 +1,90 @@
+package treesitter
+
+import (
+	"crypto/sha256"
+	"sync"
+)
+
+// QueryCache holds compiled queries so that the same query isn't compiled over and over.
+// It is safe for concurrent use.
+//
+// Cached queries are shared by all their users: they must not be closed or modified,
+// e.g. with DisableCapture or RegisterPredicate. Each goroutine executing a query
+// must use its own QueryCursor.
+type QueryCache struct {
+	mu      sync.Mutex
+	queries map[queryCacheKey]*Query
+}
+
+type queryCacheKey struct {
+	language string
+	hash     [sha256.Size]byte
+}
+
+// DefaultQueryCache is a process-wide QueryCache.
+var DefaultQueryCache = NewQueryCache()
+
+// NewQueryCache creates an empty QueryCache.
+func NewQueryCache() *QueryCache {
+	return &QueryCache{queries: map[queryCacheKey]*Query{}}
+}
+
+// GetOrCompile returns the cached query for pattern and language,
+// compiling it with NewQuery and caching it if there is none.
+// Errors are returned as from NewQuery and are not cached.
+func (c *QueryCache) GetOrCompile(pattern []byte, language string) (*Query, error) {
+	key := queryCacheKey{language: canonicalLanguage(language), hash: sha256.Sum256(pattern)}
+
+	c.mu.Lock()
+	q := c.queries[key]
+	c.mu.Unlock()
+	if q != nil {
+		return q, nil
+	}
+
+	// compile without holding the lock; if another goroutine got there first, use its query
+	q, err := NewQuery(pattern, language)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached := c.queries[key]; cached != nil {
+		return cached, nil
+	}
+	c.queries[key] = q
+	return q, nil
+}
+
+// Evict removes the query for pattern and language from the cache.
+// The query itself is left to be freed by the garbage collector, as it may still be in use.
+func (c *QueryCache) Evict(pattern []byte, language string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.queries, queryCacheKey{language: canonicalLanguage(language), hash: sha256.Sum256(pattern)})
+}
+
+// Clear removes all the queries from the cache.
+func (c *QueryCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.queries)
+}
+
+// evictLanguage removes all the queries for the language registered as langName from the cache.
+func (c *QueryCache) evictLanguage(langName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.queries {
+		if key.language == langName {
+			delete(c.queries, key)
+		}
+	}
+}
+
+// Len returns the number of cached queries.
+func (c *QueryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.queries)
+}
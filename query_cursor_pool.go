@@ -0,0 +1,48 @@
+package treesitter
+
+// #include "bindings.h"
+import "C"
+
+import (
+	"math"
+	"sync"
+)
+
+// QueryCursorPool keeps query cursors for reuse, so that code executing many queries
+// doesn't allocate and free a C cursor, and register its finalizer, for each of them.
+// It is safe for concurrent use.
+type QueryCursorPool struct {
+	pool sync.Pool
+}
+
+// DefaultQueryCursorPool is a process-wide QueryCursorPool.
+var DefaultQueryCursorPool = &QueryCursorPool{}
+
+// Get returns a cursor from the pool, creating one if the pool is empty.
+func (p *QueryCursorPool) Get() *QueryCursor {
+	if qc, ok := p.pool.Get().(*QueryCursor); ok {
+		return qc
+	}
+	return NewQueryCursor()
+}
+
+// Put resets qc and returns it to the pool. qc must not be used after Put,
+// including through the iterators and matches obtained from it.
+func (p *QueryCursorPool) Put(qc *QueryCursor) {
+	if qc == nil || qc.c == nil {
+		return
+	}
+	qc.reset()
+	p.pool.Put(qc)
+}
+
+// reset restores the ranges and limits set on qc to their defaults and
+// drops its references to the last query and tree, so they can be collected.
+func (qc *QueryCursor) reset() {
+	qc.q = nil
+	qc.t = nil
+	C.ts_query_cursor_set_byte_range(qc.c, 0, math.MaxUint32)
+	C.ts_query_cursor_set_point_range(qc.c, C.TSPoint{}, C.TSPoint{row: math.MaxUint32, column: math.MaxUint32})
+	C.ts_query_cursor_set_match_limit(qc.c, math.MaxUint32)
+	C.ts_query_cursor_set_max_start_depth(qc.c, math.MaxUint32)
+}
@@ -0,0 +1,159 @@
+package treesitter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// QueryIssue is a problem found by Query.Validate.
+type QueryIssue struct {
+	Pattern uint32 // index of the pattern
+	Offset  uint32 // byte offset in the query's source
+	Message string
+}
+
+func (i QueryIssue) String() string {
+	return fmt.Sprintf("pattern %d at offset %d: %s", i.Pattern, i.Offset, i.Message)
+}
+
+// Validate checks every node type and field name used by the query's patterns against lang
+// and reports those that lang doesn't have, which would keep the patterns from ever matching.
+//
+// The C library already rejects unknown names for the query's own language when the query is
+// created, so Validate is mostly useful to check a query shared by several grammars, e.g.
+// one written for javascript against typescript.
+func (q *Query) Validate(lang *Language) []QueryIssue {
+	return validateQuery(string(q.source), lang, q.patternAt)
+}
+
+// validateQuery checks the names used in the query source src against lang;
+// patternAt maps a byte offset in src to the index of the pattern containing it.
+func validateQuery(src string, lang *Language, patternAt func(offset uint32) uint32) []QueryIssue {
+	var issues []QueryIssue
+	report := func(offset int, format string, args ...any) {
+		issues = append(issues, QueryIssue{
+			Pattern: patternAt(uint32(offset)),
+			Offset:  uint32(offset),
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ';':
+			i = skipLine(src, i)
+		case c == '"':
+			str, end := scanQueryString(src, i)
+			if _, ok := lang.SymbolForName(str, false); !ok {
+				report(i, "unknown anonymous node %q", str)
+			}
+			i = end
+		case c == '(':
+			j := skipQuerySpace(src, i+1)
+			if j < len(src) && src[j] == '#' {
+				// predicates only refer to captures and strings
+				i = skipQueryPredicate(src, j)
+				continue
+			}
+			name, end := scanQueryIdent(src, j)
+			for _, part := range strings.Split(name, "/") {
+				if part == "" || part == "_" || part == "ERROR" || part == "MISSING" {
+					continue
+				}
+				if _, ok := lang.SymbolForName(part, true); !ok {
+					report(j, "unknown node type %q", part)
+				}
+			}
+			i = max(end, i+1)
+		case c == '!' || c == '@':
+			name, end := scanQueryIdent(src, i+1)
+			if c == '!' && lang.FieldIDForName(name) == 0 {
+				report(i+1, "unknown field %q", name)
+			}
+			i = max(end, i+1)
+		case isQueryIdentChar(c):
+			name, end := scanQueryIdent(src, i)
+			if end < len(src) && src[end] == ':' && lang.FieldIDForName(name) == 0 {
+				report(i, "unknown field %q", name)
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	return issues
+}
+
+// patternAt returns the index of the pattern containing the given byte offset.
+func (q *Query) patternAt(offset uint32) uint32 {
+	n := int(q.PatternCount())
+	i := sort.Search(n, func(i int) bool { return q.StartByteForPattern(uint32(i)) > offset })
+	return uint32(max(i-1, 0))
+}
+
+func isQueryIdentChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '_' || c == '-' || c == '.' || c == '?' || c == '!' || c == '/'
+}
+
+func scanQueryIdent(src string, i int) (string, int) {
+	start := i
+	for i < len(src) && isQueryIdentChar(src[i]) {
+		i++
+	}
+	return src[start:i], i
+}
+
+func skipQuerySpace(src string, i int) int {
+	for i < len(src) && strings.IndexByte(" \t\r\n", src[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+func skipLine(src string, i int) int {
+	if j := strings.IndexByte(src[i:], '\n'); j >= 0 {
+		return i + j + 1
+	}
+	return len(src)
+}
+
+// scanQueryString returns the value of the string literal starting at src[i] and the offset past it.
+func scanQueryString(src string, i int) (string, int) {
+	j := i + 1
+	for j < len(src) && src[j] != '"' {
+		if src[j] == '\\' {
+			j++
+		}
+		j++
+	}
+	end := min(j+1, len(src))
+	if s, err := strconv.Unquote(src[i:end]); err == nil {
+		return s, end
+	}
+	return src[i+1 : min(j, len(src))], end
+}
+
+// skipQueryPredicate returns the offset past the predicate starting at src[i], just after its "(".
+func skipQueryPredicate(src string, i int) int {
+	depth := 1
+	for i < len(src) && depth > 0 {
+		switch src[i] {
+		case '"':
+			_, i = scanQueryString(src, i)
+			continue
+		case ';':
+			i = skipLine(src, i)
+			continue
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		i++
+	}
+	return i
+}
@@ -0,0 +1,113 @@
+package semantictokens
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/boldsoftware/treesitter"
+	"github.com/boldsoftware/treesitter/highlight"
+)
+
+// SemanticTokens is the answer to full and range requests.
+type SemanticTokens struct {
+	// ResultID identifies the tokens in later delta requests.
+	ResultID string   `json:"resultId,omitempty"`
+	Data     []uint32 `json:"data"`
+}
+
+// SemanticTokensDelta is the answer to delta requests: the edits turning the data
+// of previous tokens into the current ones.
+type SemanticTokensDelta struct {
+	ResultID string               `json:"resultId,omitempty"`
+	Edits    []SemanticTokensEdit `json:"edits"`
+}
+
+// SemanticTokensEdit replaces DeleteCount integers of the data from Start with Data.
+type SemanticTokensEdit struct {
+	Start       uint32   `json:"start"`
+	DeleteCount uint32   `json:"deleteCount"`
+	Data        []uint32 `json:"data,omitempty"`
+}
+
+// Provider answers the semantic tokens requests of the documents of a language server.
+// It keeps the last tokens sent for each document, so as to answer delta requests
+// with the edits from them. It is safe for concurrent use.
+type Provider struct {
+	encoder *Encoder
+
+	mu      sync.Mutex
+	lastID  uint64
+	results map[string]SemanticTokens // by document URI
+}
+
+// NewProvider returns a provider encoding tokens with e.
+func NewProvider(e *Encoder) *Provider {
+	return &Provider{encoder: e, results: make(map[string]SemanticTokens)}
+}
+
+// Full answers a textDocument/semanticTokens/full request for the document at uri,
+// with highlights hs of its source src.
+func (p *Provider) Full(uri string, hs []highlight.Highlight, src []byte) *SemanticTokens {
+	tokens := p.remember(uri, p.encoder.Encode(hs, src))
+	return &tokens
+}
+
+// FullDelta answers a textDocument/semanticTokens/full/delta request for the document at uri,
+// with highlights hs of its source src. It returns the edits from the tokens with the
+// previous result ID, or all the tokens if they are no longer known, as the protocol allows.
+func (p *Provider) FullDelta(uri, previousResultID string, hs []highlight.Highlight, src []byte) (*SemanticTokensDelta, *SemanticTokens) {
+	p.mu.Lock()
+	prev, ok := p.results[uri]
+	p.mu.Unlock()
+
+	tokens := p.remember(uri, p.encoder.Encode(hs, src))
+	if !ok || prev.ResultID != previousResultID {
+		return nil, &tokens
+	}
+	return &SemanticTokensDelta{ResultID: tokens.ResultID, Edits: Diff(prev.Data, tokens.Data)}, nil
+}
+
+// Range answers a textDocument/semanticTokens/range request, with highlights hs of
+// the source src of the document.
+func (p *Provider) Range(hs []highlight.Highlight, src []byte, r treesitter.LSPRange) *SemanticTokens {
+	return &SemanticTokens{Data: p.encoder.EncodeRange(hs, src, r)}
+}
+
+// Forget drops the tokens kept for the document at uri, as when it is closed.
+func (p *Provider) Forget(uri string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.results, uri)
+}
+
+// remember keeps data as the last tokens of the document at uri, under a new result ID.
+func (p *Provider) remember(uri string, data []uint32) SemanticTokens {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastID++
+	tokens := SemanticTokens{ResultID: strconv.FormatUint(p.lastID, 10), Data: data}
+	p.results[uri] = tokens
+	return tokens
+}
+
+// Diff returns the edits turning the data of tokens from to the data of tokens to:
+// none if they are equal, or else one replacing what lies between their common
+// prefix and suffix.
+func Diff(from, to []uint32) []SemanticTokensEdit {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	if prefix == len(from) && prefix == len(to) {
+		return []SemanticTokensEdit{}
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+	return []SemanticTokensEdit{{
+		Start:       uint32(prefix),
+		DeleteCount: uint32(len(from) - prefix - suffix),
+		Data:        to[prefix : len(to)-suffix],
+	}}
+}
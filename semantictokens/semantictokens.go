@@ -0,0 +1,279 @@
+// Package semantictokens converts highlights into the semantic tokens of the
+// Language Server Protocol, so that language servers can serve tree-sitter highlighting
+// in answer to textDocument/semanticTokens requests.
+//
+//	h, _ := highlight.ForLanguage("go")
+//	p := semantictokens.NewProvider(semantictokens.NewEncoder(semantictokens.DefaultLegend, semantictokens.UTF16))
+//
+//	// textDocument/semanticTokens/full
+//	tokens := p.Full(uri, h.Highlight(tree, src).Highlights, src)
+//
+// The types below marshal to JSON as their namesakes of the protocol.
+package semantictokens
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/boldsoftware/treesitter"
+	"github.com/boldsoftware/treesitter/highlight"
+)
+
+// The token types and modifiers predefined by the protocol.
+var (
+	TokenTypes = []string{
+		"namespace", "type", "class", "enum", "interface", "struct", "typeParameter", "parameter",
+		"variable", "property", "enumMember", "event", "function", "method", "macro", "keyword",
+		"modifier", "comment", "string", "number", "regexp", "operator", "decorator",
+	}
+	TokenModifiers = []string{
+		"declaration", "definition", "readonly", "static", "deprecated", "abstract", "async",
+		"modification", "documentation", "defaultLibrary",
+	}
+)
+
+// Legend lists the token types and modifiers a server uses, which tokens refer to by index.
+// Servers send it to the client as part of their capabilities.
+type Legend struct {
+	TokenTypes     []string `json:"tokenTypes"`
+	TokenModifiers []string `json:"tokenModifiers"`
+}
+
+// DefaultLegend is the legend of all the token types and modifiers predefined by the protocol.
+var DefaultLegend = Legend{TokenTypes: TokenTypes, TokenModifiers: TokenModifiers}
+
+// tokenType is the token type and modifiers of a highlight name.
+type tokenType struct {
+	typ       string
+	modifiers []string
+}
+
+// names maps highlight names, and the first part of other names, to the token types they stand for.
+var names = map[string]tokenType{
+	"attribute":        {typ: "decorator"},
+	"comment":          {typ: "comment"},
+	"constant":         {"variable", []string{"readonly"}},
+	"constant.builtin": {"variable", []string{"readonly", "defaultLibrary"}},
+	"constructor":      {typ: "class"},
+	"enum":             {typ: "enum"},
+	"escape":           {typ: "string"},
+	"function":         {typ: "function"},
+	"function.macro":   {typ: "macro"},
+	"function.method":  {typ: "method"},
+	// the macros defined by the C preprocessor
+	"function.special":   {typ: "macro"},
+	"keyword":            {typ: "keyword"},
+	"method":             {typ: "method"},
+	"module":             {typ: "namespace"},
+	"namespace":          {typ: "namespace"},
+	"number":             {typ: "number"},
+	"operator":           {typ: "operator"},
+	"parameter":          {typ: "parameter"},
+	"property":           {typ: "property"},
+	"string":             {typ: "string"},
+	"string.regex":       {typ: "regexp"},
+	"string.regexp":      {typ: "regexp"},
+	"type":               {typ: "type"},
+	"type.parameter":     {typ: "typeParameter"},
+	"variable":           {typ: "variable"},
+	"variable.parameter": {typ: "parameter"},
+}
+
+// partModifiers maps the parts of highlight names after the first to the token modifiers they stand for.
+var partModifiers = map[string]string{
+	"builtin":       "defaultLibrary",
+	"definition":    "definition",
+	"documentation": "documentation",
+	"readonly":      "readonly",
+	"static":        "static",
+}
+
+// TokenType returns the standard token type and modifiers of a highlight name such as
+// "function.builtin", or false if it has none, as for punctuation.
+// A name is looked up in full, and if that fails, by its first part, with its other parts
+// mapped to modifiers.
+func TokenType(name string) (typ string, modifiers []string, ok bool) {
+	if t, ok := names[name]; ok {
+		return t.typ, t.modifiers, true
+	}
+	first, rest, _ := strings.Cut(name, ".")
+	t, ok := names[first]
+	if !ok {
+		return "", nil, false
+	}
+	mods := slices.Clone(t.modifiers)
+	for _, part := range strings.Split(rest, ".") {
+		if m, ok := partModifiers[part]; ok && !slices.Contains(mods, m) {
+			mods = append(mods, m)
+		}
+	}
+	return t.typ, mods, true
+}
+
+// PositionEncoding is the encoding of the strings whose code units count the characters of positions,
+// as negotiated by the client and the server.
+type PositionEncoding string
+
+const (
+	UTF8  PositionEncoding = "utf-8"
+	UTF16 PositionEncoding = "utf-16" // the default of the protocol
+	UTF32 PositionEncoding = "utf-32"
+)
+
+// units returns the number of code units of b in the encoding.
+func (enc PositionEncoding) units(b []byte) int {
+	switch enc {
+	case UTF8:
+		return len(b)
+	case UTF32:
+		return utf8.RuneCount(b)
+	}
+	n := 0
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		if r >= 0x10000 {
+			n++ // a surrogate pair
+		}
+		n++
+		b = b[size:]
+	}
+	return n
+}
+
+func before(p, other treesitter.LSPPosition) bool {
+	return p.Line < other.Line || p.Line == other.Line && p.Character < other.Character
+}
+
+// Encoder converts highlights into semantic tokens.
+type Encoder struct {
+	legend    Legend
+	encoding  PositionEncoding
+	types     map[string]uint32
+	modifiers map[string]uint32
+
+	// MultilineTokens is whether the client supports tokens spanning several lines.
+	// If it doesn't, highlights spanning several lines, such as comments, are split into
+	// a token per line.
+	MultilineTokens bool
+}
+
+// NewEncoder returns an encoder of tokens referring to the types and modifiers of legend,
+// with positions in the given encoding. Highlights whose token type isn't in the legend are dropped,
+// as are modifiers not in it.
+func NewEncoder(legend Legend, encoding PositionEncoding) *Encoder {
+	e := &Encoder{
+		legend:    legend,
+		encoding:  encoding,
+		types:     make(map[string]uint32),
+		modifiers: make(map[string]uint32),
+	}
+	if e.encoding == "" {
+		e.encoding = UTF16
+	}
+	for i, typ := range legend.TokenTypes {
+		e.types[typ] = uint32(i)
+	}
+	for i, m := range legend.TokenModifiers {
+		e.modifiers[m] = uint32(i)
+	}
+	return e
+}
+
+// Legend returns the legend of the tokens.
+func (e *Encoder) Legend() Legend { return e.legend }
+
+// token is a token before the encoding of its position relatively to the previous one.
+type token struct {
+	start     treesitter.LSPPosition
+	end       treesitter.LSPPosition
+	length    uint32
+	typ       uint32
+	modifiers uint32
+}
+
+// tokens returns the tokens of the highlights of src, in order.
+func (e *Encoder) tokens(hs []highlight.Highlight, src []byte) []token {
+	var tokens []token
+	for _, h := range hs {
+		typName, mods, ok := TokenType(h.Name)
+		if !ok {
+			continue
+		}
+		typ, ok := e.types[typName]
+		if !ok {
+			continue
+		}
+		var modifiers uint32
+		for _, m := range mods {
+			if i, ok := e.modifiers[m]; ok {
+				modifiers |= 1 << i
+			}
+		}
+
+		line, lineStart := h.StartPoint.Row, h.StartByte-h.StartPoint.Column
+		start := h.StartByte
+		for start < h.EndByte {
+			end := h.EndByte
+			if !e.MultilineTokens {
+				if i := slices.Index(src[start:end], '\n'); i >= 0 {
+					end = start + i
+				}
+			}
+			// line terminators aren't part of tokens
+			text := src[start:end]
+			if !e.MultilineTokens {
+				text = bytes.TrimSuffix(text, []byte("\r"))
+			}
+			if len(text) > 0 {
+				t := token{
+					start:     treesitter.LSPPosition{Line: uint32(line), Character: uint32(e.encoding.units(src[lineStart:start]))},
+					length:    uint32(e.encoding.units(text)),
+					typ:       typ,
+					modifiers: modifiers,
+				}
+				endLine, endLineStart := line, lineStart
+				if i := bytes.LastIndexByte(text, '\n'); i >= 0 {
+					endLine, endLineStart = line+bytes.Count(text, []byte("\n")), start+i+1
+				}
+				t.end = treesitter.LSPPosition{Line: uint32(endLine), Character: uint32(e.encoding.units(src[endLineStart : start+len(text)]))}
+				tokens = append(tokens, t)
+			}
+			start, line, lineStart = end+1, line+1, end+1
+		}
+	}
+	return tokens
+}
+
+// encode returns the data of tokens, five integers per token: the line of its start,
+// relative to the previous token's, its start character, relative to the previous token's
+// if on the same line, its length, and the indices of its type and modifiers in the legend,
+// the latter as a bit set.
+func encode(tokens []token) []uint32 {
+	data := make([]uint32, 0, 5*len(tokens))
+	var prev treesitter.LSPPosition
+	for _, t := range tokens {
+		char := t.start.Character
+		if t.start.Line == prev.Line {
+			char -= prev.Character
+		}
+		data = append(data, t.start.Line-prev.Line, char, t.length, t.typ, t.modifiers)
+		prev = t.start
+	}
+	return data
+}
+
+// Encode returns the data of the semantic tokens of highlights hs of src.
+func (e *Encoder) Encode(hs []highlight.Highlight, src []byte) []uint32 {
+	return encode(e.tokens(hs, src))
+}
+
+// EncodeRange returns the data of the semantic tokens of highlights hs of src
+// which intersect r.
+func (e *Encoder) EncodeRange(hs []highlight.Highlight, src []byte, r treesitter.LSPRange) []uint32 {
+	tokens := slices.DeleteFunc(e.tokens(hs, src), func(t token) bool {
+		return !before(t.start, r.End) || !before(r.Start, t.end)
+	})
+	return encode(tokens)
+}
@@ -0,0 +1,182 @@
+package semantictokens_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/boldsoftware/treesitter"
+	"github.com/boldsoftware/treesitter/highlight"
+	_ "github.com/boldsoftware/treesitter/langs/all"
+	"github.com/boldsoftware/treesitter/semantictokens"
+	"github.com/stretchr/testify/assert"
+)
+
+func highlights(t *testing.T, src string) []highlight.Highlight {
+	t.Helper()
+	tree, err := treesitter.NewParser("go").Parse(context.Background(), nil, []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := highlight.ForLanguage("go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h.Highlight(tree, []byte(src)).Highlights
+}
+
+// token is a decoded token, with its type and modifiers named after DefaultLegend.
+type token struct {
+	line, char, length int
+	typ                string
+	modifiers          []string
+}
+
+func decode(data []uint32) []token {
+	var tokens []token
+	var line, char int
+	for i := 0; i < len(data); i += 5 {
+		if data[i] > 0 {
+			char = 0
+		}
+		line += int(data[i])
+		char += int(data[i+1])
+		t := token{line: line, char: char, length: int(data[i+2]), typ: semantictokens.TokenTypes[data[i+3]]}
+		for j, m := range semantictokens.TokenModifiers {
+			if data[i+4]&(1<<j) != 0 {
+				t.modifiers = append(t.modifiers, m)
+			}
+		}
+		tokens = append(tokens, t)
+	}
+	return tokens
+}
+
+func TestTokenType(t *testing.T) {
+	assert := assert.New(t)
+	for name, want := range map[string]struct {
+		typ       string
+		modifiers []string
+	}{
+		"keyword":            {"keyword", nil},
+		"function.builtin":   {"function", []string{"defaultLibrary"}},
+		"function.method":    {"method", nil},
+		"constant.builtin":   {"variable", []string{"readonly", "defaultLibrary"}},
+		"variable.parameter": {"parameter", nil},
+		"type.builtin":       {"type", []string{"defaultLibrary"}},
+		"string.special":     {"string", nil},
+	} {
+		typ, modifiers, ok := semantictokens.TokenType(name)
+		assert.True(ok, name)
+		assert.Equal(want.typ, typ, name)
+		assert.Equal(want.modifiers, modifiers, name)
+	}
+	for _, name := range []string{"punctuation.bracket", "embedded", "label", ""} {
+		_, _, ok := semantictokens.TokenType(name)
+		assert.False(ok, name)
+	}
+}
+
+const src = "package main\n\n/* 🙂\n   ok */\nfunc run() { println(\"é🙂\") }\n"
+
+func TestEncode(t *testing.T) {
+	assert := assert.New(t)
+	hs := highlights(t, src)
+
+	e := semantictokens.NewEncoder(semantictokens.DefaultLegend, semantictokens.UTF16)
+	assert.Equal(semantictokens.DefaultLegend, e.Legend())
+	assert.Equal([]token{
+		{0, 0, 7, "keyword", nil},
+		{0, 8, 4, "namespace", nil},
+		// a token per line of the comment
+		{2, 0, 5, "comment", nil},
+		{3, 0, 8, "comment", nil},
+		{4, 0, 4, "keyword", nil},
+		{4, 5, 3, "function", nil},
+		{4, 13, 7, "function", []string{"defaultLibrary"}},
+		// the emoji is a surrogate pair
+		{4, 21, 5, "string", nil},
+	}, decode(e.Encode(hs, []byte(src))))
+
+	e = semantictokens.NewEncoder(semantictokens.DefaultLegend, semantictokens.UTF8)
+	e.MultilineTokens = true
+	tokens := decode(e.Encode(hs, []byte(src)))
+	assert.Equal(token{2, 0, 16, "comment", nil}, tokens[2])
+	assert.Equal(token{4, 21, 8, "string", nil}, tokens[6])
+
+	// types and modifiers missing from the legend are dropped
+	e = semantictokens.NewEncoder(semantictokens.Legend{TokenTypes: []string{"function", "string"}}, semantictokens.UTF32)
+	assert.Equal([]uint32{4, 5, 3, 0, 0, 0, 8, 7, 0, 0, 0, 8, 4, 1, 0}, e.Encode(hs, []byte(src)))
+}
+
+func TestEncodeRange(t *testing.T) {
+	assert := assert.New(t)
+	hs := highlights(t, src)
+
+	e := semantictokens.NewEncoder(semantictokens.DefaultLegend, semantictokens.UTF16)
+	e.MultilineTokens = true
+	data := e.EncodeRange(hs, []byte(src), treesitter.LSPRange{
+		Start: treesitter.LSPPosition{Line: 3, Character: 2},
+		End:   treesitter.LSPPosition{Line: 4, Character: 6},
+	})
+	assert.Equal([]token{
+		{2, 0, 14, "comment", nil},
+		{4, 0, 4, "keyword", nil},
+		{4, 5, 3, "function", nil},
+	}, decode(data))
+}
+
+func TestProvider(t *testing.T) {
+	assert := assert.New(t)
+	p := semantictokens.NewProvider(semantictokens.NewEncoder(semantictokens.DefaultLegend, ""))
+
+	full := p.Full("file:///a.go", highlights(t, src), []byte(src))
+	assert.NotEmpty(full.ResultID)
+
+	newSrc := "package main\n\nfunc run() { println(\"é🙂\") }\n"
+	delta, tokens := p.FullDelta("file:///a.go", full.ResultID, highlights(t, newSrc), []byte(newSrc))
+	assert.Nil(tokens)
+	assert.NotEqual(full.ResultID, delta.ResultID)
+	data := slices.Clone(full.Data)
+	for _, edit := range delta.Edits {
+		data = slices.Replace(data, int(edit.Start), int(edit.Start+edit.DeleteCount), edit.Data...)
+	}
+	again := p.Full("file:///b.go", highlights(t, newSrc), []byte(newSrc))
+	assert.Equal(again.Data, data)
+
+	// unknown results get all the tokens
+	delta, tokens = p.FullDelta("file:///a.go", full.ResultID, highlights(t, newSrc), []byte(newSrc))
+	assert.Nil(delta)
+	assert.Equal(again.Data, tokens.Data)
+	p.Forget("file:///b.go")
+	delta, tokens = p.FullDelta("file:///b.go", again.ResultID, highlights(t, newSrc), []byte(newSrc))
+	assert.Nil(delta)
+	assert.NotNil(tokens)
+
+	b, err := json.Marshal(p.Range(nil, nil, treesitter.LSPRange{}))
+	assert.NoError(err)
+	assert.JSONEq(`{"data": []}`, string(b))
+}
+
+func TestDiff(t *testing.T) {
+	assert := assert.New(t)
+	for _, tc := range []struct{ from, to []uint32 }{
+		{nil, nil},
+		{[]uint32{1, 2, 3}, []uint32{1, 2, 3}},
+		{[]uint32{1, 2, 3}, []uint32{1, 4, 3}},
+		{[]uint32{1, 2, 3}, []uint32{1, 3}},
+		{[]uint32{1, 3}, []uint32{1, 2, 2, 3}},
+		{nil, []uint32{1}},
+		{[]uint32{1, 1}, []uint32{1}},
+	} {
+		edits := semantictokens.Diff(tc.from, tc.to)
+		assert.NotNil(edits)
+		assert.LessOrEqual(len(edits), 1)
+		data := slices.Clone(tc.from)
+		for _, edit := range edits {
+			data = slices.Replace(data, int(edit.Start), int(edit.Start+edit.DeleteCount), edit.Data...)
+		}
+		assert.True(slices.Equal(tc.to, data), "%v -> %v: %v", tc.from, tc.to, data)
+	}
+}
@@ -0,0 +1,54 @@
+package treesitter
+
+import "sync"
+
+// SharedTree allows a single parse result to be used from many goroutines.
+//
+// A Tree must not be used on several goroutines at once; SharedTree hands out
+// a private copy of its tree to each user instead. Copies are cheap, as they
+// share all of the tree's nodes.
+type SharedTree struct {
+	mu   sync.Mutex
+	tree *Tree
+}
+
+// NewSharedTree wraps t for sharing. t must not be used directly afterwards.
+func NewSharedTree(t *Tree) *SharedTree {
+	return &SharedTree{tree: t}
+}
+
+// Acquire returns a copy of the tree for use by the calling goroutine.
+// The copy should be returned with Release once the goroutine is done with it.
+// Acquire panics if the SharedTree has been closed.
+func (s *SharedTree) Acquire() *Tree {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tree == nil {
+		panic("shared tree is closed")
+	}
+	return s.tree.Copy()
+}
+
+// Release frees a copy obtained from Acquire.
+// Neither the copy nor its nodes may be used afterwards.
+func (s *SharedTree) Release(t *Tree) {
+	t.Close()
+}
+
+// Close frees the shared tree. Copies already acquired remain usable until released.
+// Closing twice is harmless.
+func (s *SharedTree) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tree != nil {
+		s.tree.Close()
+		s.tree = nil
+	}
+}
+
+// Do calls fn with a copy of the tree that is released when fn returns.
+func (s *SharedTree) Do(fn func(t *Tree)) {
+	t := s.Acquire()
+	defer s.Release(t)
+	fn(t)
+}
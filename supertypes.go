@@ -0,0 +1,53 @@
+package treesitter
+
+// #include "bindings.h"
+import "C"
+
+// maxSupertypes bounds the supertypes a node can stand for at once, as in tree-sitter's queries.
+const maxSupertypes = 8
+
+// Supertypes returns the supertypes of the language: hidden node types such as _expression,
+// which stand for any of several node types and can be used as such in queries.
+func (l *Language) Supertypes() []Symbol {
+	var supertypes []Symbol
+	for i := range l.SymbolCount() {
+		if C.language_symbol_is_supertype((*C.TSLanguage)(l.ptr), Symbol(i)) {
+			supertypes = append(supertypes, Symbol(i))
+		}
+	}
+	return supertypes
+}
+
+// Subtypes returns the node types supertype stands for, some of which may be supertypes themselves.
+//
+// The parse tables of the grammars supported by this version of tree-sitter don't list them,
+// so they are taken from the node types set with SetNodeTypes, and Subtypes returns nil if
+// there are none.
+func (l *Language) Subtypes(supertype Symbol) []Symbol {
+	t, ok := l.NodeType(l.SymbolName(supertype), true)
+	if !ok {
+		return nil
+	}
+	var subtypes []Symbol
+	for _, ref := range t.Subtypes {
+		if s, ok := l.SymbolForName(ref.Type, ref.Named); ok {
+			subtypes = append(subtypes, s)
+		}
+	}
+	return subtypes
+}
+
+// Supertypes returns the supertypes n stands for where it is in the tree, innermost first,
+// e.g. _expression and _literal for a number used as an expression in a grammar with
+// those supertypes.
+func (n Node) Supertypes() []Symbol {
+	if n.IsNull() {
+		return nil
+	}
+	var buf [maxSupertypes]Symbol
+	count := C.node_supertypes(n.c, &buf[0], C.uint32_t(len(buf)))
+	if count == 0 {
+		return nil
+	}
+	return buf[:count:count]
+}
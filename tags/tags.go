@@ -0,0 +1,209 @@
+// Package tags finds the definitions of and references to named entities, such as functions
+// and calls to them, with tags queries such as the ones bundled in the queries package.
+// Tags are what ctags-like indexes and go-to-definition are built from.
+//
+// Tags queries capture the name of each entity as @name, along with a node captured as
+// @definition.<kind> or @reference.<kind>, e.g. @definition.function or @reference.call.
+// The comments documenting a definition can be captured as @doc, each of their lines
+// stripped of what #strip! matches, and restricted with #select-adjacent! to those
+// right before the definition:
+//
+//	(
+//	  (comment)* @doc
+//	  .
+//	  (function_declaration name: (identifier) @name) @definition.function
+//	  (#strip! @doc "^//\\s*")
+//	  (#select-adjacent! @doc @definition.function)
+//	)
+package tags
+
+import (
+	"cmp"
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/boldsoftware/treesitter"
+	"github.com/boldsoftware/treesitter/queries"
+)
+
+// Tag is a definition of or a reference to a named entity.
+type Tag struct {
+	Name string
+	// Kind is the kind of entity, such as "function", "method", "class" or "call".
+	Kind         string
+	IsDefinition bool
+	// Range is the range of the definition or reference, such as a whole function declaration.
+	Range treesitter.Range
+	// NameRange is the range of the name.
+	NameRange treesitter.Range
+	// Docs is the documentation of a definition, from the comments before it.
+	Docs string
+}
+
+// pattern holds the directives of a pattern of a tags query.
+type pattern struct {
+	strip *regexp.Regexp
+	// adjacent is the capture the documentation must be right before,
+	// or -1 if it isn't restricted
+	adjacent int
+}
+
+// Tagger finds tags with a tags query.
+type Tagger struct {
+	q        *treesitter.Query
+	patterns []pattern
+}
+
+// New returns a tagger using the tags query q.
+func New(q *treesitter.Query) *Tagger {
+	t := &Tagger{q: q, patterns: make([]pattern, q.PatternCount())}
+	for i := range t.patterns {
+		p := &t.patterns[i]
+		p.adjacent = -1
+		for _, pred := range q.ParsedPredicates(uint32(i)) {
+			switch pred.Operator {
+			case "strip!":
+				// the query checked that it compiles
+				p.strip, _ = regexp.Compile(pred.Args[1].Value)
+			case "select-adjacent!", "set-adjacent!":
+				if len(pred.Args) == 2 && pred.Args[1].IsCapture() {
+					p.adjacent = pred.Args[1].ID
+				}
+			}
+		}
+	}
+	return t
+}
+
+// ForLanguage returns a tagger using the bundled tags query of language.
+func ForLanguage(language string) (*Tagger, error) {
+	q, err := queries.Tags(language)
+	if err != nil {
+		return nil, err
+	}
+	return New(q), nil
+}
+
+// Tags returns the tags of the tree of root, parsed from src, ordered by the position of their names.
+//
+// A name has a single tag: a definition rather than a reference, and otherwise the tag
+// of the first pattern of the query that matches it.
+func (t *Tagger) Tags(root treesitter.Node, src []byte) []Tag {
+	qc := treesitter.DefaultQueryCursorPool.Get()
+	defer treesitter.DefaultQueryCursorPool.Put(qc)
+
+	type found struct {
+		Tag
+		pattern uint16
+	}
+	var tags []found
+	byName := make(map[[2]int]int) // index of the tag of each name range
+	for m := range qc.Matches(t.q, root, src) {
+		var tag found
+		var name, node treesitter.Node
+		var docs []treesitter.Node
+		for _, c := range m.Captures {
+			switch kind, isDef := strings.CutPrefix(c.Name, "definition."); {
+			case c.Name == "name":
+				name = c.Node
+			case c.Name == "doc":
+				docs = append(docs, c.Node)
+			case isDef:
+				node, tag.Kind, tag.IsDefinition = c.Node, kind, true
+			case strings.HasPrefix(c.Name, "reference."):
+				node, tag.Kind = c.Node, strings.TrimPrefix(c.Name, "reference.")
+			}
+		}
+		if name.IsNull() || node.IsNull() {
+			continue
+		}
+		tag.Name = name.Text(src)
+		tag.Range, tag.NameRange = node.Range(), name.Range()
+		tag.pattern = m.PatternIndex
+		if tag.IsDefinition {
+			tag.Docs = t.docs(m, docs, src)
+		}
+
+		key := [2]int{tag.NameRange.StartByte, tag.NameRange.EndByte}
+		i, ok := byName[key]
+		if !ok {
+			byName[key] = len(tags)
+			tags = append(tags, tag)
+			continue
+		}
+		prev := tags[i]
+		switch {
+		case prev.IsDefinition != tag.IsDefinition:
+			if tag.IsDefinition {
+				tags[i] = tag
+			}
+		case tag.pattern < prev.pattern:
+			tags[i] = tag
+		case tag.pattern == prev.pattern && len(tag.Docs) > len(prev.Docs):
+			// the same pattern matches with fewer of the comments before a definition
+			tags[i] = tag
+		}
+	}
+
+	slices.SortFunc(tags, func(a, b found) int {
+		return cmp.Compare(a.NameRange.StartByte, b.NameRange.StartByte)
+	})
+	result := make([]Tag, len(tags))
+	for i, tag := range tags {
+		result[i] = tag.Tag
+	}
+	return result
+}
+
+// docs returns the documentation of the definition of m from its doc comments.
+func (t *Tagger) docs(m *treesitter.QueryMatch, docs []treesitter.Node, src []byte) string {
+	p := t.patterns[m.PatternIndex]
+	if p.adjacent >= 0 {
+		var next treesitter.Node
+		for _, c := range m.Captures {
+			if c.Index == p.adjacent {
+				next = c.Node
+			}
+		}
+		// only keep the comments with no blank line between them and the definition
+		first := len(docs)
+		for !next.IsNull() && first > 0 && docs[first-1].EndPoint().Row+1 >= next.StartPoint().Row {
+			first--
+			next = docs[first]
+		}
+		docs = docs[first:]
+	}
+
+	var lines []string
+	for _, doc := range docs {
+		for _, line := range strings.Split(doc.Text(src), "\n") {
+			if p.strip != nil {
+				line = p.strip.ReplaceAllString(line, "")
+			}
+			lines = append(lines, strings.TrimRight(line, " \t\r"))
+		}
+	}
+	// drop what is left of the delimiters of block comments
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Extract parses src as the given language and returns its tags, using the bundled tags query.
+func Extract(ctx context.Context, src []byte, language string) ([]Tag, error) {
+	t, err := ForLanguage(language)
+	if err != nil {
+		return nil, err
+	}
+	root, err := treesitter.Parse(ctx, src, language)
+	if err != nil {
+		return nil, err
+	}
+	return t.Tags(root, src), nil
+}
@@ -0,0 +1,110 @@
+package tags_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/boldsoftware/treesitter"
+	_ "github.com/boldsoftware/treesitter/langs/all"
+	"github.com/boldsoftware/treesitter/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+// tag is the gist of a tags.Tag.
+type tag struct {
+	name, kind string
+	def        bool
+	row        int
+	docs       string
+}
+
+func extract(t *testing.T, src, language string) []tag {
+	t.Helper()
+	ts, err := tags.Extract(context.Background(), []byte(src), language)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gist []tag
+	for _, tt := range ts {
+		assert.Equal(t, tt.Name, src[tt.NameRange.StartByte:tt.NameRange.EndByte])
+		assert.True(t, tt.Range.StartByte <= tt.NameRange.StartByte && tt.NameRange.EndByte <= tt.Range.EndByte)
+		gist = append(gist, tag{tt.Name, tt.Kind, tt.IsDefinition, tt.NameRange.StartPoint.Row, tt.Docs})
+	}
+	return gist
+}
+
+func TestGo(t *testing.T) {
+	src := `package main
+
+// Section
+
+// T is a thing.
+type T struct{}
+
+// Run runs.
+//
+// Really.
+func (t T) Run() error { return nil }
+
+// unrelated
+
+// main is the entry point.
+func main() { T{}.Run() }
+`
+	assert.Equal(t, []tag{
+		{"main", "module", true, 0, ""},
+		{"T", "type", true, 5, ""},
+		{"T", "type", false, 10, ""},
+		{"Run", "method", true, 10, "Run runs.\n\nReally."},
+		{"error", "type", false, 10, ""},
+		// the comment before the blank line isn't documentation
+		{"main", "function", true, 15, "main is the entry point."},
+		{"T", "type", false, 15, ""},
+		{"Run", "call", false, 15, ""},
+	}, extract(t, src, "go"))
+}
+
+func TestJavascript(t *testing.T) {
+	src := "/**\n * Adds.\n */\nfunction add(a, b) { return a + b }\nclass C {\n  // m does.\n  m() {}\n}\nadd(1, 2); new C(); require('x')\n"
+	assert.Equal(t, []tag{
+		{"add", "function", true, 3, "Adds."},
+		{"C", "class", true, 4, ""},
+		{"m", "method", true, 6, "m does."},
+		{"add", "call", false, 8, ""},
+		{"C", "class", false, 8, ""},
+	}, extract(t, src, "javascript"))
+}
+
+func TestAllLanguages(t *testing.T) {
+	for _, tc := range []struct {
+		lang, src string
+		row       int
+	}{
+		{"c", "int f(int x) { return g(x); }\n", 0},
+		{"go", "package main\nfunc f(x int) { g(x) }\n", 1},
+		{"javascript", "function f(x) { g(x) }\n", 0},
+		{"typescript", "function f(x: number) { g(x) }\n", 0},
+	} {
+		gist := extract(t, tc.src, tc.lang)
+		assert.Contains(t, gist, tag{"f", "function", true, tc.row, ""}, tc.lang)
+		assert.Contains(t, gist, tag{"g", "call", false, tc.row, ""}, tc.lang)
+	}
+
+	_, err := tags.Extract(context.Background(), nil, "cobol")
+	assert.Error(t, err)
+}
+
+func TestTagger(t *testing.T) {
+	assert := assert.New(t)
+
+	q, err := treesitter.NewQuery([]byte(`((comment)+ @doc . (function_declaration name: (identifier) @name) @definition.function)`), "go")
+	assert.NoError(err)
+	src := []byte("package main\n\n// a\n// b\n\n// c\nfunc f() {}\n")
+	root, err := treesitter.Parse(context.Background(), src, "go")
+	assert.NoError(err)
+	ts := tags.New(q).Tags(root, src)
+	if assert.Len(ts, 1) {
+		// with neither #strip! nor #select-adjacent!, all the comments are kept as they are
+		assert.Equal("// a\n// b\n// c", ts[0].Docs)
+	}
+}
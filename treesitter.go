@@ -5,17 +5,24 @@ import "C"
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"iter"
+	"maps"
 	"math"
+	"os"
 	"reflect"
 	"regexp"
 	"runtime"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
@@ -36,22 +43,68 @@ type Parser struct {
 	c      *C.TSParser
 	cancel *uintptr
 	lang   *Language
+	// arena owns the parser and the trees it produces, if set.
+	arena *Arena
+	// err is why the language couldn't be set, returned by parses instead of ErrNoLanguage.
+	err error
 }
 
 // NewParser creates new Parser.
+// It panics if the language has not been registered; see NewParserChecked.
 func NewParser(language string) *Parser {
-	lang := languages[language]
-	if lang == nil {
-		panic(fmt.Sprintf("language %s not found; missing import _ statement", language))
+	p, err := NewParserChecked(language)
+	if err != nil {
+		panic(err.Error())
 	}
+	return p
+}
+
+// NewParserChecked creates new Parser.
+// It returns an error wrapping ErrUnknownLanguage if the language has not been registered.
+func NewParserChecked(language string) (*Parser, error) {
+	lang, ok := LookupLanguage(language)
+	if !ok {
+		return nil, fmt.Errorf("%w %s; missing import _ statement", ErrUnknownLanguage, language)
+	}
+	p := NewParserFromLanguage(lang)
+	if p.err != nil {
+		p.Close()
+		return nil, p.err
+	}
+	return p, nil
+}
+
+// NewParserFromLanguage creates new Parser for the given Language.
+//
+// Unlike NewParser it does not consult the language registry, so it can be
+// used with languages that were never passed to RegisterLanguage.
+//
+// If the WebAssembly store a language loaded with LoadLanguageWASM runs in can't
+// be created, the parser has no language, and its parses return an error wrapping
+// ErrNoLanguage that says why.
+func NewParserFromLanguage(lang *Language) *Parser {
 	cancel := uintptr(0)
 	p := &Parser{c: C.ts_parser_new(), cancel: &cancel, lang: lang}
 	C.ts_parser_set_cancellation_flag(p.c, (*C.size_t)(unsafe.Pointer(p.cancel)))
-	C.ts_parser_set_language(p.c, (*C.struct_TSLanguage)(lang.ptr))
+	if newWasmStore != nil && bool(C.ts_language_is_wasm((*C.TSLanguage)(lang.ptr))) {
+		if store, err := newWasmStore(); err != nil {
+			p.err = fmt.Errorf("%w: creating WebAssembly store: %w", ErrNoLanguage, err)
+		} else {
+			// the parser owns the store and deletes it with itself
+			C.ts_parser_set_wasm_store(p.c, store)
+		}
+	}
+	if p.err == nil {
+		C.ts_parser_set_language(p.c, (*C.struct_TSLanguage)(lang.ptr))
+	}
 	runtime.SetFinalizer(p, (*Parser).Close)
 	return p
 }
 
+// newWasmStore creates the store a parser needs to run a language loaded from WebAssembly.
+// It is only set in builds with the treesitter_wasm tag; see LoadLanguageWASM.
+var newWasmStore func() (*C.TSWasmStore, error)
+
 // maintain a map of read functions that can be called from C
 var readFuncs = &readFuncsMap{funcs: make(map[int]ReadFunc)}
 
@@ -74,12 +127,38 @@ type Input struct {
 }
 
 var (
-	ErrOperationLimit = errors.New("operation limit was hit")
-	ErrNoLanguage     = errors.New("cannot parse without language")
+	ErrOperationLimit       = errors.New("operation limit was hit")
+	ErrNoLanguage           = errors.New("cannot parse without language")
+	ErrUnknownLanguage      = errors.New("unknown language")
+	ErrInvalidEdit          = errors.New("invalid edit")
+	ErrIncompatibleLanguage = errors.New("incompatible language version")
 )
 
 // Parse produces new Tree from content using old tree
 func (p *Parser) Parse(ctx context.Context, oldTree *Tree, content []byte) (*Tree, error) {
+	input := C.CBytes(content)
+	defer C.free(input)
+
+	return p.parseString(ctx, oldTree, (*C.char)(input), len(content))
+}
+
+// ParseNoCopy is like Parse but hands content to the parser in place
+// instead of copying it to C memory first, halving peak memory for large inputs.
+//
+// content is pinned for the duration of the call and is not referenced
+// by the returned Tree, but it must not be modified until ParseNoCopy returns.
+func (p *Parser) ParseNoCopy(ctx context.Context, oldTree *Tree, content []byte) (*Tree, error) {
+	data := unsafe.SliceData(content)
+	if data != nil {
+		var pinner runtime.Pinner
+		pinner.Pin(data)
+		defer pinner.Unpin()
+	}
+
+	return p.parseString(ctx, oldTree, (*C.char)(unsafe.Pointer(data)), len(content))
+}
+
+func (p *Parser) parseString(ctx context.Context, oldTree *Tree, input *C.char, length int) (*Tree, error) {
 	var cTree *C.TSTree
 	if oldTree != nil {
 		cTree = oldTree.c
@@ -99,12 +178,70 @@ func (p *Parser) Parse(ctx context.Context, oldTree *Tree, content []byte) (*Tre
 		}()
 	}
 
+	cTree = C.ts_parser_parse_string(p.c, cTree, input, C.uint32_t(length))
+	close(parseComplete)
+
+	return p.convertTSTree(ctx, cTree)
+}
+
+// ParseKeepSource is like Parse but the returned Tree retains content,
+// so that its nodes can return their own text with Node.Text(nil) and Node.Bytes(nil).
+//
+// content is not copied and must not be modified while the Tree is in use.
+func (p *Parser) ParseKeepSource(ctx context.Context, oldTree *Tree, content []byte) (*Tree, error) {
+	tree, err := p.Parse(ctx, oldTree, content)
+	if err != nil {
+		return nil, err
+	}
+	tree.source = content
+	return tree, nil
+}
+
+// ParseWithTimeout produces new Tree from content using old tree,
+// giving up with context.DeadlineExceeded once timeout has elapsed.
+//
+// Unlike Parse with a cancelable context, the limit is enforced by the parser itself
+// so no goroutine is started per call. If the parser's operation limit is shorter, it applies
+// instead and ErrOperationLimit is returned once it is hit.
+func (p *Parser) ParseWithTimeout(oldTree *Tree, content []byte, timeout time.Duration) (*Tree, error) {
+	if timeout <= 0 {
+		return nil, context.DeadlineExceeded
+	}
+
+	var cTree *C.TSTree
+	if oldTree != nil {
+		cTree = oldTree.c
+	}
+
+	limit := C.ts_parser_timeout_micros(p.c)
+	micros := C.uint64_t(max(timeout.Microseconds(), 1))
+	limited := limit != 0 && limit < micros
+	if limited {
+		micros = limit
+	}
+	C.ts_parser_set_timeout_micros(p.c, micros)
 	input := C.CBytes(content)
 	cTree = C.ts_parser_parse_string(p.c, cTree, (*C.char)(input), C.uint32_t(len(content)))
-	close(parseComplete)
 	C.free(input)
+	C.ts_parser_set_timeout_micros(p.c, limit)
 
-	return p.convertTSTree(ctx, cTree)
+	if cTree == nil {
+		if C.ts_parser_language(p.c) == nil {
+			return nil, p.noLanguage()
+		}
+		// don't resume the abandoned parse on the next call
+		C.ts_parser_reset(p.c)
+		if limited {
+			return nil, ErrOperationLimit
+		}
+		return nil, context.DeadlineExceeded
+	}
+	return p.newTree(cTree), nil
+}
+
+// ParseWithDeadline is like ParseWithTimeout but stops parsing at the given deadline.
+func (p *Parser) ParseWithDeadline(oldTree *Tree, content []byte, deadline time.Time) (*Tree, error) {
+	return p.ParseWithTimeout(oldTree, content, time.Until(deadline))
 }
 
 // ParseInput produces new Tree by reading from a callback defined in input
@@ -143,7 +280,7 @@ func (p *Parser) convertTSTree(ctx context.Context, tsTree *C.TSTree) (*Tree, er
 		}
 
 		if C.ts_parser_language(p.c) == nil {
-			return nil, ErrNoLanguage
+			return nil, p.noLanguage()
 		}
 
 		return nil, ErrOperationLimit
@@ -152,6 +289,14 @@ func (p *Parser) convertTSTree(ctx context.Context, tsTree *C.TSTree) (*Tree, er
 	return p.newTree(tsTree), nil
 }
 
+// noLanguage returns the error for parses without a language: why it couldn't be set, if known.
+func (p *Parser) noLanguage() error {
+	if p.err != nil {
+		return p.err
+	}
+	return ErrNoLanguage
+}
+
 // OperationLimit returns the duration in microseconds that parsing is allowed to take
 func (p *Parser) OperationLimit() int {
 	return int(C.ts_parser_timeout_micros(p.c))
@@ -210,6 +355,10 @@ type Point struct {
 	Column int
 }
 
+func (p Point) before(other Point) bool {
+	return p.Row < other.Row || (p.Row == other.Row && p.Column < other.Column)
+}
+
 type Range struct {
 	StartPoint Point
 	EndPoint   Point
@@ -223,13 +372,19 @@ type Range struct {
 // for details see: https://github.com/golang/go/issues/7358#issuecomment-66091558
 type baseTree struct {
 	c *C.TSTree
+	// arena owns the tree, if set.
+	arena *Arena
 }
 
 // newTree creates a new tree object from a C pointer. The function will set a finalizer for the object,
 // thus no free is needed for it.
 func (p *Parser) newTree(c *C.TSTree) *Tree {
-	base := &baseTree{c: c}
-	runtime.SetFinalizer(base, (*baseTree).Close)
+	base := &baseTree{c: c, arena: p.arena}
+	if p.arena != nil {
+		p.arena.own(base, base.Close)
+	} else {
+		runtime.SetFinalizer(base, (*baseTree).Close)
+	}
 
 	newTree := &Tree{p: p, baseTree: base}
 	return newTree
@@ -244,11 +399,22 @@ type Tree struct {
 	// p is a pointer to a Parser that produced the Tree. Only used to keep Parser alive.
 	// Otherwise Parser may be GC'ed (and deleted by the finalizer) while some Tree objects are still in use.
 	p *Parser
+
+	// source is the parsed content, only set for trees produced by ParseKeepSource.
+	source []byte
 }
 
 // Copy returns a new copy of a tree
 func (t *Tree) Copy() *Tree {
-	return t.p.newTree(C.ts_tree_copy(t.c))
+	newTree := t.p.newTree(C.ts_tree_copy(t.c))
+	newTree.source = t.source
+	return newTree
+}
+
+// Source returns the content the tree was parsed from,
+// or nil if the tree was not produced by ParseKeepSource.
+func (t *Tree) Source() []byte {
+	return t.source
 }
 
 // RootNode returns root node of a tree
@@ -257,6 +423,17 @@ func (t *Tree) RootNode() Node {
 	return Node{c: (C.TSNode)(n), t: t}
 }
 
+// Cursor returns a new tree cursor starting from the root node of the tree.
+func (t *Tree) Cursor() *TreeCursor {
+	return NewTreeCursor(t.RootNode())
+}
+
+// AllNodes returns an iterator over all the nodes of the tree in depth-first pre-order.
+// It walks the tree with a single TreeCursor and doesn't allocate per node.
+func (t *Tree) AllNodes() iter.Seq[Node] {
+	return t.RootNode().PreOrder()
+}
+
 func (t *Tree) goString(ptr *C.char) string {
 	return t.p.lang.goString(ptr)
 }
@@ -269,6 +446,9 @@ func (t *baseTree) Close() {
 	if t.c != nil {
 		C.ts_tree_delete(t.c)
 		t.c = nil
+		if t.arena != nil {
+			t.arena.disown(t)
+		}
 	}
 }
 
@@ -295,12 +475,62 @@ func (i EditInput) c() *C.TSInputEdit {
 			column: C.uint32_t(i.OldEndPoint.Column),
 		},
 		new_end_point: C.TSPoint{
-			row:    C.uint32_t(i.OldEndPoint.Row),
-			column: C.uint32_t(i.OldEndPoint.Column),
+			row:    C.uint32_t(i.NewEndPoint.Row),
+			column: C.uint32_t(i.NewEndPoint.Column),
 		},
 	}
 }
 
+// ChangedRanges compares the tree to newTree, a tree produced by parsing the edited source
+// with t as the old tree, and returns the ranges whose syntactic structure has changed.
+//
+// t must have been edited with Edit to match the new source before newTree was parsed.
+func (t *Tree) ChangedRanges(newTree *Tree) []Range {
+	var length C.uint32_t
+	cRanges := C.ts_tree_get_changed_ranges(t.c, newTree.c, &length)
+	defer C.free(unsafe.Pointer(cRanges))
+	defer runtime.KeepAlive(newTree)
+	defer runtime.KeepAlive(t)
+
+	ranges := make([]Range, int(length))
+	for i, r := range unsafe.Slice(cRanges, int(length)) {
+		ranges[i] = Range{
+			StartPoint: Point{Row: int(r.start_point.row), Column: int(r.start_point.column)},
+			EndPoint:   Point{Row: int(r.end_point.row), Column: int(r.end_point.column)},
+			StartByte:  int(r.start_byte),
+			EndByte:    int(r.end_byte),
+		}
+	}
+	return ranges
+}
+
+// PrintDotGraph writes a Graphviz DOT representation of the tree to w.
+func (t *Tree) PrintDotGraph(w io.Writer) error {
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer pr.Close()
+
+	copyErr := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(w, pr)
+		if err != nil {
+			// Keep draining the pipe so ts_tree_print_dot_graph never blocks
+			// on a full pipe buffer once w has failed.
+			io.Copy(io.Discard, pr)
+		}
+		copyErr <- err
+	}()
+
+	C.ts_tree_print_dot_graph(t.c, C.int(pw.Fd()))
+	runtime.KeepAlive(t)
+	if err := pw.Close(); err != nil {
+		return err
+	}
+	return <-copyErr
+}
+
 // Edit the syntax tree to keep it in sync with source code that has been edited.
 func (t *Tree) Edit(i EditInput) {
 	if t.c == nil {
@@ -309,7 +539,72 @@ func (t *Tree) Edit(i EditInput) {
 	C.ts_tree_edit(t.c, i.c())
 }
 
-var languages = map[string]*Language{}
+// ApplyEdits edits the syntax tree with a set of non-overlapping edits in one call.
+//
+// All edits must be expressed in the coordinates of the source before any of them
+// were applied; they may be given in any order. The edits are validated before any
+// is applied, and an error wrapping ErrInvalidEdit is returned if one is malformed
+// or overlaps another. On success it returns the edited ranges, in order,
+// in the coordinates of the new source.
+func (t *Tree) ApplyEdits(edits []EditInput) ([]Range, error) {
+	if t.c == nil {
+		panic("tree is closed")
+	}
+
+	sorted := slices.Clone(edits)
+	slices.SortStableFunc(sorted, func(a, b EditInput) int {
+		return cmp.Compare(a.StartIndex, b.StartIndex)
+	})
+	for i, e := range sorted {
+		if e.StartIndex < 0 || e.OldEndIndex < e.StartIndex || e.NewEndIndex < e.StartIndex {
+			return nil, fmt.Errorf("%w: byte range %d-%d-%d is not ordered", ErrInvalidEdit, e.StartIndex, e.OldEndIndex, e.NewEndIndex)
+		}
+		if e.OldEndPoint.before(e.StartPoint) || e.NewEndPoint.before(e.StartPoint) {
+			return nil, fmt.Errorf("%w: point range %v-%v-%v is not ordered", ErrInvalidEdit, e.StartPoint, e.OldEndPoint, e.NewEndPoint)
+		}
+		if i > 0 {
+			prev := sorted[i-1]
+			if e.StartIndex == prev.StartIndex || e.StartIndex < prev.OldEndIndex || e.StartPoint.before(prev.OldEndPoint) {
+				return nil, fmt.Errorf("%w: edit at byte %d overlaps edit at byte %d", ErrInvalidEdit, e.StartIndex, prev.StartIndex)
+			}
+		}
+	}
+
+	// apply back to front so that every edit is still expressed in the coordinates of the tree
+	for _, e := range slices.Backward(sorted) {
+		C.ts_tree_edit(t.c, e.c())
+	}
+
+	ranges := make([]Range, len(sorted))
+	var oldEnd, newEnd Range // identity mapping before the first edit
+	for i, e := range sorted {
+		startPoint := shiftPoint(e.StartPoint, oldEnd.EndPoint, newEnd.EndPoint)
+		startByte := e.StartIndex - oldEnd.EndByte + newEnd.EndByte
+		ranges[i] = Range{
+			StartPoint: startPoint,
+			EndPoint:   shiftPoint(e.NewEndPoint, e.StartPoint, startPoint),
+			StartByte:  startByte,
+			EndByte:    startByte + e.NewEndIndex - e.StartIndex,
+		}
+		oldEnd = Range{EndPoint: e.OldEndPoint, EndByte: e.OldEndIndex}
+		newEnd = ranges[i]
+	}
+	return ranges, nil
+}
+
+// shiftPoint moves p, which is at or after from, by the distance between from and to.
+func shiftPoint(p, from, to Point) Point {
+	if p.Row == from.Row {
+		return Point{Row: to.Row, Column: to.Column + p.Column - from.Column}
+	}
+	return Point{Row: p.Row - from.Row + to.Row, Column: p.Column}
+}
+
+var (
+	// registryMu guards the registries of languages, aliases and profiles
+	registryMu sync.RWMutex
+	languages  = map[string]*Language{}
+)
 
 // RegisterLanguage registers a language with the parser.
 // It is called on init from packages that contain a language parser. E.g.
@@ -317,17 +612,101 @@ var languages = map[string]*Language{}
 //	import _ "github.com/boldsoftware/treesitter/golang"
 //
 // calls RegisterLanguage("go", l) allowing go to be used as a language.
+//
+// Registering the same grammar again under the same name does nothing; registering another one
+// under a name already in use, or any under the name of an alias, panics. Languages can be registered at any time, e.g. when loading
+// grammars at runtime, concurrently with their use.
 func RegisterLanguage(langName string, l *Language) {
-	if languages[langName] != nil {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if registered := languages[langName]; registered != nil {
+		if registered.ptr == l.ptr {
+			return
+		}
 		panic("language " + langName + " already registered")
 	}
+	if aliases[langName] != "" {
+		panic("language " + langName + " already registered as an alias")
+	}
 	languages[langName] = l
 }
 
+// UnregisterLanguage removes the language registered as langName, with its profile and
+// the queries for it in DefaultQueryCache, e.g. before registering a newer version of a grammar
+// loaded at runtime. Its aliases are kept, so that they apply to a language registered again
+// under the same name.
+//
+// Parsers, trees and queries created for the language are unaffected: grammars are never
+// unloaded, see LoadLanguageFromLibrary.
+func UnregisterLanguage(langName string) {
+	registryMu.Lock()
+	delete(languages, langName)
+	delete(profiles, langName)
+	registryMu.Unlock()
+	DefaultQueryCache.evictLanguage(langName)
+}
+
+// aliases maps the alternate names of languages to the names they are registered under
+var aliases = map[string]string{}
+
+// RegisterAlias makes alias an alternate name for the language registered as langName,
+// e.g. RegisterAlias("golang", "go"), so that it can be used wherever a language name is expected.
+// The language doesn't need to be registered yet.
+func RegisterAlias(alias, langName string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if languages[alias] != nil || aliases[alias] != "" {
+		panic("language " + alias + " already registered")
+	}
+	aliases[alias] = langName
+}
+
+// canonicalLanguage returns the name langName is an alias of, or langName if it isn't an alias.
+func canonicalLanguage(langName string) string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return resolveAlias(langName)
+}
+
+// resolveAlias is canonicalLanguage, for callers holding registryMu.
+func resolveAlias(langName string) string {
+	if name, ok := aliases[langName]; ok {
+		return name
+	}
+	return langName
+}
+
+// Languages returns the sorted names of the registered languages, not including aliases.
+func Languages() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return slices.Sorted(maps.Keys(languages))
+}
+
+// LookupLanguage returns the language registered under the given name or alias.
+func LookupLanguage(langName string) (*Language, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	l, ok := languages[resolveAlias(langName)]
+	return l, ok
+}
+
 // Language defines how to parse a particular programming language
 type Language struct {
-	ptr      unsafe.Pointer
-	cstrings map[*C.char]string // unchanged after NewLanguage
+	ptr       unsafe.Pointer
+	cstrings  map[*C.char]string // unchanged after NewLanguage
+	nodeTypes []NodeType         // set by SetNodeTypes
+}
+
+// checkLanguageVersion returns an error wrapping ErrIncompatibleLanguage if the language
+// at ptr wasn't generated for a version of tree-sitter this package supports.
+func checkLanguageVersion(ptr unsafe.Pointer) error {
+	version := C.ts_language_version((*C.TSLanguage)(ptr))
+	if version < C.TREE_SITTER_MIN_COMPATIBLE_LANGUAGE_VERSION || version > C.TREE_SITTER_LANGUAGE_VERSION {
+		return fmt.Errorf("%w %d, supported versions are %d to %d", ErrIncompatibleLanguage, version,
+			C.TREE_SITTER_MIN_COMPATIBLE_LANGUAGE_VERSION, C.TREE_SITTER_LANGUAGE_VERSION)
+	}
+	return nil
 }
 
 // NewLanguage creates new Language from c pointer
@@ -359,6 +738,15 @@ func (l *Language) cSymbolName(s Symbol) *C.char {
 // SymbolName returns a node type string for the given Symbol.
 func (l *Language) SymbolName(s Symbol) string { return l.goString(l.cSymbolName(s)) }
 
+// SymbolForName returns the Symbol for the given node type and whether there is one.
+// named selects between named node types and anonymous ones such as punctuation.
+func (l *Language) SymbolForName(name string, named bool) (Symbol, bool) {
+	str := C.CString(name)
+	defer C.free(unsafe.Pointer(str))
+	s := C.ts_language_symbol_for_name((*C.TSLanguage)(l.ptr), str, C.uint32_t(len(name)), C.bool(named))
+	return s, s != 0
+}
+
 // SymbolType returns named, anonymous, or a hidden type for a Symbol.
 func (l *Language) SymbolType(s Symbol) SymbolType {
 	return SymbolType(C.ts_language_symbol_type((*C.TSLanguage)(l.ptr), s))
@@ -375,10 +763,29 @@ func (l *Language) cFieldName(idx int) *C.char {
 
 func (l *Language) FieldName(idx int) string { return l.goString(l.cFieldName(idx)) }
 
+// FieldIDForName returns the FieldID of the field with the given name, or 0 if there is none.
+func (l *Language) FieldIDForName(name string) FieldID {
+	str := C.CString(name)
+	defer C.free(unsafe.Pointer(str))
+	return FieldID(C.ts_language_field_id_for_name((*C.TSLanguage)(l.ptr), str, C.uint32_t(len(name))))
+}
+
 func (l *Language) FieldCount() int {
 	return int(C.ts_language_field_count((*C.TSLanguage)(l.ptr)))
 }
 
+// StateCount returns the number of states in the language's parse table.
+func (l *Language) StateCount() int {
+	return int(C.ts_language_state_count((*C.TSLanguage)(l.ptr)))
+}
+
+// NextState returns the parse state reached from state after a node of type symbol,
+// as Node.NextParseState does for a node. Use the node's GrammarSymbol rather than
+// its Symbol, which may be an alias. It returns 0 if there is no such transition.
+func (l *Language) NextState(state StateID, symbol Symbol) StateID {
+	return StateID(C.ts_language_next_state((*C.TSLanguage)(l.ptr), C.TSStateId(state), symbol))
+}
+
 // Node represents a single node in the syntax tree.
 //
 // It tracks its start and end positions in the source code,
@@ -430,6 +837,32 @@ func (n Node) Range() Range {
 	}
 }
 
+// Text returns the text of the node in src, the content the node's tree was parsed from.
+// If src is nil, the source retained by ParseKeepSource is used.
+//
+// It returns "" if there is no source or the node lies outside of it.
+func (n Node) Text(src []byte) string {
+	return string(n.Bytes(src))
+}
+
+// Bytes returns the bytes of the node in src, the content the node's tree was parsed from,
+// sharing memory with src. If src is nil, the source retained by ParseKeepSource is used.
+//
+// It returns nil if there is no source or the node lies outside of it.
+func (n Node) Bytes(src []byte) []byte {
+	if n.t == nil {
+		return nil
+	}
+	if src == nil {
+		src = n.t.source
+	}
+	start, end := n.StartByte(), n.EndByte()
+	if start > end || end > len(src) {
+		return nil
+	}
+	return src[start:end]
+}
+
 // Symbol returns the node's type as a Symbol.
 func (n Node) Symbol() Symbol {
 	return C.ts_node_symbol(n.c)
@@ -440,6 +873,16 @@ func (n Node) Type() string {
 	return n.t.goString(C.ts_node_type(n.c))
 }
 
+// GrammarSymbol returns the node's type as it appears in the grammar, ignoring aliases.
+func (n Node) GrammarSymbol() Symbol {
+	return C.ts_node_grammar_symbol(n.c)
+}
+
+// GrammarType returns the node's type as it appears in the grammar, ignoring aliases.
+func (n Node) GrammarType() string {
+	return n.t.goString(C.ts_node_grammar_type(n.c))
+}
+
 // String returns an S-expression representing the node as a string.
 func (n Node) String() string {
 	if n == (Node{}) {
@@ -502,6 +945,18 @@ func (n Node) HasError() bool {
 	return bool(C.ts_node_has_error(n.c))
 }
 
+// ParseState returns the parse state of the node.
+func (n Node) ParseState() StateID {
+	return StateID(C.ts_node_parse_state(n.c))
+}
+
+// NextParseState returns the parse state after the node.
+//
+// Combined with a lookahead iterator it can be used to compute the tokens valid after the node.
+func (n Node) NextParseState() StateID {
+	return StateID(C.ts_node_next_parse_state(n.c))
+}
+
 // Parent returns the node's immediate parent.
 func (n Node) Parent() Node {
 	nn := C.ts_node_parent(n.c)
@@ -553,6 +1008,30 @@ func (n Node) NamedChildren() iter.Seq2[int, Node] {
 	}
 }
 
+// ChildNodes returns all of n's children, fetched with a single cgo call.
+func (n Node) ChildNodes() []Node {
+	return n.childNodes(false, n.ChildCount())
+}
+
+// NamedChildNodes returns all of n's named children, fetched with a single cgo call.
+func (n Node) NamedChildNodes() []Node {
+	return n.childNodes(true, n.NamedChildCount())
+}
+
+func (n Node) childNodes(named bool, count int) []Node {
+	if count == 0 {
+		return nil
+	}
+	cs := make([]C.TSNode, count)
+	count = int(C.node_children(n.c, C.bool(named), unsafe.SliceData(cs), C.uint32_t(count)))
+	runtime.KeepAlive(n.t)
+	nodes := make([]Node, count)
+	for i, c := range cs[:count] {
+		nodes[i] = Node{c: c, t: n.t}
+	}
+	return nodes
+}
+
 // ChildByFieldName returns the node's child with the given field name.
 func (n Node) ChildByFieldName(name string) Node {
 	str := C.CString(name)
@@ -561,6 +1040,18 @@ func (n Node) ChildByFieldName(name string) Node {
 	return Node{c: (C.TSNode)(nn), t: n.t}
 }
 
+// ChildByFieldID returns the node's child with the given field ID.
+func (n Node) ChildByFieldID(id FieldID) Node {
+	nn := C.ts_node_child_by_field_id(n.c, C.TSFieldId(id))
+	return Node{c: (C.TSNode)(nn), t: n.t}
+}
+
+// FieldIDForChild returns the field ID of the child at the given index, or 0 if it has no field.
+func (n Node) FieldIDForChild(idx int) FieldID {
+	defer runtime.KeepAlive(n.t)
+	return FieldID(C.node_field_id_for_child(n.c, C.uint32_t(idx)))
+}
+
 // FieldNameForChild returns the field name of the child at the given index, or "" if not named.
 func (n Node) FieldNameForChild(idx int) string {
 	return n.t.goString(C.ts_node_field_name_for_child(n.c, C.uint32_t(idx)))
@@ -590,11 +1081,53 @@ func (n Node) PrevNamedSibling() Node {
 	return Node{c: (C.TSNode)(nn), t: n.t}
 }
 
+// FirstChildForByte returns the node's first child that extends beyond the given byte offset.
+func (n Node) FirstChildForByte(offset int) Node {
+	nn := C.ts_node_first_child_for_byte(n.c, C.uint32_t(offset))
+	return Node{c: (C.TSNode)(nn), t: n.t}
+}
+
+// FirstNamedChildForByte returns the node's first *named* child that extends beyond the given byte offset.
+func (n Node) FirstNamedChildForByte(offset int) Node {
+	nn := C.ts_node_first_named_child_for_byte(n.c, C.uint32_t(offset))
+	return Node{c: (C.TSNode)(nn), t: n.t}
+}
+
 // Edit the node to keep it in-sync with source code that has been edited.
 func (n Node) Edit(i EditInput) {
 	C.ts_node_edit(&n.c, i.c())
 }
 
+// DescendantForByteRange returns the smallest node within this node that spans the given range of bytes,
+// including anonymous nodes such as punctuation.
+func (n Node) DescendantForByteRange(start, end int) Node {
+	nn := C.ts_node_descendant_for_byte_range(n.c, C.uint32_t(start), C.uint32_t(end))
+	return Node{c: (C.TSNode)(nn), t: n.t}
+}
+
+// NamedDescendantForByteRange returns the smallest named node within this node that spans the given range of bytes.
+func (n Node) NamedDescendantForByteRange(start, end int) Node {
+	nn := C.ts_node_named_descendant_for_byte_range(n.c, C.uint32_t(start), C.uint32_t(end))
+	return Node{c: (C.TSNode)(nn), t: n.t}
+}
+
+// DescendantForPointRange returns the smallest node within this node that spans the given range of
+// (row, column) positions, including anonymous nodes such as punctuation.
+func (n Node) DescendantForPointRange(start Point, end Point) Node {
+	cStartPoint := C.TSPoint{
+		row:    C.uint32_t(start.Row),
+		column: C.uint32_t(start.Column),
+	}
+	cEndPoint := C.TSPoint{
+		row:    C.uint32_t(end.Row),
+		column: C.uint32_t(end.Column),
+	}
+	nn := C.ts_node_descendant_for_point_range(n.c, cStartPoint, cEndPoint)
+	return Node{c: (C.TSNode)(nn), t: n.t}
+}
+
+// NamedDescendantForPointRange returns the smallest named node within this node that spans the given range of
+// (row, column) positions.
 func (n Node) NamedDescendantForPointRange(start Point, end Point) Node {
 	cStartPoint := C.TSPoint{
 		row:    C.uint32_t(start.Row),
@@ -610,6 +1143,9 @@ func (n Node) NamedDescendantForPointRange(start Point, end Point) Node {
 
 type Symbol = C.TSSymbol
 
+// StateID identifies a state of a Language's parse table.
+type StateID uint16
+
 type SymbolType int
 
 const (
@@ -664,6 +1200,24 @@ func (c *TreeCursor) Reset(n Node) {
 	C.ts_tree_cursor_reset(c.c, n.c)
 }
 
+// Copy returns a new cursor at the same position as c, which can then be moved independently.
+func (c *TreeCursor) Copy() *TreeCursor {
+	cc := C.ts_tree_cursor_copy(c.c)
+	nc := &TreeCursor{
+		c: &cc,
+		t: c.t,
+	}
+	runtime.SetFinalizer(nc, (*TreeCursor).Close)
+	return nc
+}
+
+// ResetTo moves c to the same position as other, which may be on a different tree.
+// Unlike Reset, it keeps other's ancestry, so c can still go to the parents of the current node.
+func (c *TreeCursor) ResetTo(other *TreeCursor) {
+	C.ts_tree_cursor_reset_to(c.c, other.c)
+	c.t = other.t
+}
+
 // CurrentNode of the tree cursor.
 func (c *TreeCursor) CurrentNode() Node {
 	n := C.ts_tree_cursor_current_node(c.c)
@@ -678,6 +1232,14 @@ func (c *TreeCursor) CurrentFieldName() string {
 	return c.t.goString(C.ts_tree_cursor_current_field_name(c.c))
 }
 
+// CurrentFieldID gets the field ID of the tree cursor's current node.
+//
+// This returns 0 if the current node doesn't have a field.
+func (c *TreeCursor) CurrentFieldID() FieldID {
+	defer runtime.KeepAlive(c.t)
+	return FieldID(C.ts_tree_cursor_current_field_id(c.c))
+}
+
 // GoToParent moves the cursor to the parent of its current node.
 //
 // This returns `true` if the cursor successfully moved, and returns `false`
@@ -715,6 +1277,42 @@ func (c *TreeCursor) GoToFirstChildForByte(b uint32) int64 {
 	return int64(C.ts_tree_cursor_goto_first_child_for_byte(c.c, C.uint32_t(b)))
 }
 
+// GoToFirstChildForPoint moves the cursor to the first child of its current node
+// that extends beyond the given point.
+//
+// This returns the index of the child node if one was found, and returns -1
+// if no such child was found.
+func (c *TreeCursor) GoToFirstChildForPoint(p Point) int64 {
+	defer runtime.KeepAlive(c.t)
+	cp := C.TSPoint{
+		row:    C.uint32_t(p.Row),
+		column: C.uint32_t(p.Column),
+	}
+	return int64(C.ts_tree_cursor_goto_first_child_for_point(c.c, cp))
+}
+
+// GoToDescendant moves the cursor to the nth descendant of the original node
+// that the cursor was constructed with, where zero represents the original node itself.
+// Descendants are numbered in pre-order, as with CurrentDescendantIndex.
+func (c *TreeCursor) GoToDescendant(index uint32) {
+	defer runtime.KeepAlive(c.t)
+	C.ts_tree_cursor_goto_descendant(c.c, C.uint32_t(index))
+}
+
+// CurrentDescendantIndex returns the index of the cursor's current node among all
+// the descendants of the original node that the cursor was constructed with.
+func (c *TreeCursor) CurrentDescendantIndex() uint32 {
+	defer runtime.KeepAlive(c.t)
+	return uint32(C.ts_tree_cursor_current_descendant_index(c.c))
+}
+
+// CurrentDepth returns the depth of the cursor's current node relative to the original node
+// that the cursor was constructed with, which has depth zero.
+func (c *TreeCursor) CurrentDepth() uint32 {
+	defer runtime.KeepAlive(c.t)
+	return uint32(C.ts_tree_cursor_current_depth(c.c))
+}
+
 // QueryErrorType - value that indicates the type of QueryError.
 type QueryErrorType int
 
@@ -761,6 +1359,18 @@ func (qe *QueryError) Error() string {
 // Query API
 type Query struct {
 	c *C.TSQuery
+	// source is the text the query was created from
+	source []byte
+	// predicates holds the predicates registered with RegisterPredicate
+	predicates map[string]PredicateFunc
+	// captureNames holds the capture names by id
+	captureNames []string
+	// regexes holds the compiled regular expressions of the predicates, by string id
+	regexes map[int]*regexp.Regexp
+	// patternPredicates holds the predicates of each pattern, as from PredicatesForPattern
+	patternPredicates [][][]QueryPredicateStep
+	// assertions holds the #is? and #is-not? assertions of each pattern
+	assertions [][]QueryAssertion
 }
 
 // NewQuery creates a query by specifying a string containing one or more patterns.
@@ -770,9 +1380,9 @@ func NewQuery(pattern []byte, language string) (*Query, error) {
 		erroff  C.uint32_t
 		errtype C.TSQueryError
 	)
-	lang := languages[language]
-	if lang == nil {
-		return nil, fmt.Errorf("unknown language %s; missing import _ statement", language)
+	lang, ok := LookupLanguage(language)
+	if !ok {
+		return nil, fmt.Errorf("%w %s; missing import _ statement", ErrUnknownLanguage, language)
 	}
 
 	input := C.CBytes(pattern)
@@ -846,12 +1456,21 @@ func NewQuery(pattern []byte, language string) (*Query, error) {
 		}
 	}
 
-	q := &Query{c: c}
+	q := &Query{c: c, source: bytes.Clone(pattern)}
+	q.captureNames = make([]string, q.CaptureCount())
+	for i := range q.captureNames {
+		var length C.uint32_t
+		name := C.ts_query_capture_name_for_id(c, C.uint32_t(i), &length)
+		q.captureNames[i] = C.GoStringN(name, C.int(length))
+	}
 
 	// Copied from: https://github.com/klothoplatform/go-tree-sitter/commit/e351b20167b26d515627a4a1a884528ede5fef79
 	// this is just used for syntax validation - it does not actually filter anything
+	q.patternPredicates = make([][][]QueryPredicateStep, q.PatternCount())
+	q.assertions = make([][]QueryAssertion, q.PatternCount())
 	for i := uint32(0); i < q.PatternCount(); i++ {
 		predicates := q.PredicatesForPattern(i)
+		q.patternPredicates[i] = predicates
 		for _, steps := range predicates {
 			if len(steps) == 0 {
 				continue
@@ -880,6 +1499,69 @@ func NewQuery(pattern []byte, language string) (*Query, error) {
 				if steps[2].Type != QueryPredicateStepTypeString {
 					return nil, fmt.Errorf("second argument of `#%s` predicate must be a string. Got %s", operator, q.StringValueForId(steps[2].ValueId))
 				}
+				if err := q.compileRegex(steps[2].ValueId); err != nil {
+					return nil, fmt.Errorf("second argument of `#%s` predicate must be a regular expression: %w", operator, err)
+				}
+			case "any-eq?", "any-not-eq?":
+				if len(steps) != 4 {
+					return nil, fmt.Errorf("wrong number of arguments to `#%s` predicate. Expected 2, got %d", operator, len(steps)-2)
+				}
+				if steps[1].Type != QueryPredicateStepTypeCapture {
+					return nil, fmt.Errorf("first argument of `#%s` predicate must be a capture. Got %s", operator, q.StringValueForId(steps[1].ValueId))
+				}
+			case "any-match?", "any-not-match?":
+				if len(steps) != 4 {
+					return nil, fmt.Errorf("wrong number of arguments to `#%s` predicate. Expected 2, got %d", operator, len(steps)-2)
+				}
+				if steps[1].Type != QueryPredicateStepTypeCapture {
+					return nil, fmt.Errorf("first argument of `#%s` predicate must be a capture. Got %s", operator, q.StringValueForId(steps[1].ValueId))
+				}
+				if steps[2].Type != QueryPredicateStepTypeString {
+					return nil, fmt.Errorf("second argument of `#%s` predicate must be a string. Got %s", operator, q.StringValueForId(steps[2].ValueId))
+				}
+				if err := q.compileRegex(steps[2].ValueId); err != nil {
+					return nil, fmt.Errorf("second argument of `#%s` predicate must be a regular expression: %w", operator, err)
+				}
+			case "any-of?", "not-any-of?", "contains?":
+				if len(steps) < 4 {
+					return nil, fmt.Errorf("wrong number of arguments to `#%s` predicate. Expected at least 2, got %d", operator, len(steps)-2)
+				}
+				if steps[1].Type != QueryPredicateStepTypeCapture {
+					return nil, fmt.Errorf("first argument of `#%s` predicate must be a capture. Got %s", operator, q.StringValueForId(steps[1].ValueId))
+				}
+				for _, step := range steps[2 : len(steps)-1] {
+					if step.Type != QueryPredicateStepTypeString {
+						return nil, fmt.Errorf("arguments of `#%s` predicate after the first must be strings. Got @%s", operator, q.CaptureNameForId(step.ValueId))
+					}
+				}
+			case "offset!":
+				if len(steps) != 7 {
+					return nil, fmt.Errorf("wrong number of arguments to `#%s` directive. Expected 5, got %d", operator, len(steps)-2)
+				}
+				if steps[1].Type != QueryPredicateStepTypeCapture {
+					return nil, fmt.Errorf("first argument of `#%s` directive must be a capture. Got %s", operator, q.StringValueForId(steps[1].ValueId))
+				}
+				for _, step := range steps[2:6] {
+					if step.Type != QueryPredicateStepTypeString {
+						return nil, fmt.Errorf("arguments of `#%s` directive after the first must be integers. Got @%s", operator, q.CaptureNameForId(step.ValueId))
+					}
+					if _, err := strconv.Atoi(q.StringValueForId(step.ValueId)); err != nil {
+						return nil, fmt.Errorf("arguments of `#%s` directive after the first must be integers. Got %s", operator, q.StringValueForId(step.ValueId))
+					}
+				}
+			case "strip!":
+				if len(steps) != 4 {
+					return nil, fmt.Errorf("wrong number of arguments to `#%s` directive. Expected 2, got %d", operator, len(steps)-2)
+				}
+				if steps[1].Type != QueryPredicateStepTypeCapture {
+					return nil, fmt.Errorf("first argument of `#%s` directive must be a capture. Got %s", operator, q.StringValueForId(steps[1].ValueId))
+				}
+				if steps[2].Type != QueryPredicateStepTypeString {
+					return nil, fmt.Errorf("second argument of `#%s` directive must be a string. Got @%s", operator, q.CaptureNameForId(steps[2].ValueId))
+				}
+				if err := q.compileRegex(steps[2].ValueId); err != nil {
+					return nil, fmt.Errorf("second argument of `#%s` directive must be a regular expression: %w", operator, err)
+				}
 			case "set!", "is?", "is-not?":
 				if len(steps) < 3 || len(steps) > 4 {
 					return nil, fmt.Errorf("wrong number of arguments to `#%s` predicate. Expected 1 or 2, got %d", operator, len(steps)-2)
@@ -887,9 +1569,16 @@ func NewQuery(pattern []byte, language string) (*Query, error) {
 				if steps[1].Type != QueryPredicateStepTypeString {
 					return nil, fmt.Errorf("first argument of `#%s` predicate must be a string. Got %s", operator, q.StringValueForId(steps[1].ValueId))
 				}
-				if len(steps) > 2 && steps[2].Type != QueryPredicateStepTypeString {
+				if len(steps) > 3 && steps[2].Type != QueryPredicateStepTypeString {
 					return nil, fmt.Errorf("second argument of `#%s` predicate must be a string. Got %s", operator, q.StringValueForId(steps[2].ValueId))
 				}
+				if operator != "set!" {
+					a := QueryAssertion{Key: q.StringValueForId(steps[1].ValueId), Positive: operator == "is?"}
+					if len(steps) > 3 {
+						a.Value = q.StringValueForId(steps[2].ValueId)
+					}
+					q.assertions[i] = append(q.assertions[i], a)
+				}
 			}
 		}
 	}
@@ -899,6 +1588,23 @@ func NewQuery(pattern []byte, language string) (*Query, error) {
 	return q, nil
 }
 
+// compileRegex compiles the regular expression in the string with the given id
+// for the predicates to use.
+func (q *Query) compileRegex(id int) error {
+	if _, ok := q.regexes[id]; ok {
+		return nil
+	}
+	regex, err := regexp.Compile(q.StringValueForId(id))
+	if err != nil {
+		return err
+	}
+	if q.regexes == nil {
+		q.regexes = map[int]*regexp.Regexp{}
+	}
+	q.regexes[id] = regex
+	return nil
+}
+
 // Close should be called to ensure that all the memory used by the query is freed.
 //
 // As the constructor in go-tree-sitter would set this func call through runtime.SetFinalizer,
@@ -958,10 +1664,84 @@ func (q *Query) PredicatesForPattern(patternIndex uint32) [][]QueryPredicateStep
 	return splitPredicates(predicateSteps)
 }
 
+// QueryPredicateArg is an argument of a query predicate: either a capture or a string.
+type QueryPredicateArg struct {
+	Type  QueryPredicateStepType // QueryPredicateStepTypeCapture or QueryPredicateStepTypeString
+	ID    int                    // capture or string id
+	Value string                 // capture name or string value
+}
+
+// IsCapture reports whether the argument is a capture.
+func (a QueryPredicateArg) IsCapture() bool { return a.Type == QueryPredicateStepTypeCapture }
+
+// QueryPredicate is a predicate or directive of a pattern, such as (#eq? @a "b") or (#set! "key" "value").
+type QueryPredicate struct {
+	Operator string // e.g. "eq?" or "set!"
+	Args     []QueryPredicateArg
+}
+
+// ParsedPredicates returns the predicates of the given pattern, as PredicatesForPattern
+// with the steps decoded.
+func (q *Query) ParsedPredicates(patternIndex uint32) []QueryPredicate {
+	var predicates []QueryPredicate
+	for _, steps := range q.patternPredicates[patternIndex] {
+		predicates = append(predicates, QueryPredicate{
+			Operator: q.StringValueForId(steps[0].ValueId),
+			Args:     predicateArgs(q, steps),
+		})
+	}
+	return predicates
+}
+
+// PredicateFunc evaluates a custom predicate for a match m of a query on input.
+// args are the arguments following the predicate's name.
+type PredicateFunc func(args []QueryPredicateArg, m *QueryMatch, input []byte) bool
+
+// builtinPredicates holds the names of the predicates and directives FilterPredicates handles.
+var builtinPredicates = []string{
+	"eq?", "not-eq?", "any-eq?", "any-not-eq?",
+	"match?", "not-match?", "any-match?", "any-not-match?",
+	"any-of?", "not-any-of?", "contains?",
+	"set!", "is?", "is-not?", "offset!", "strip!",
+}
+
+// RegisterPredicate makes FilterPredicates call fn to evaluate the predicates of the query
+// named name, e.g. "in-scope?" for (#in-scope? @var). Predicates with no function registered
+// are ignored. It panics if name is one of the standard predicates or directives, such as eq?
+// or set!, which can't be overridden.
+//
+// RegisterPredicate must not be called concurrently with the use of the query,
+// so not on the shared queries of a QueryCache.
+func (q *Query) RegisterPredicate(name string, fn PredicateFunc) {
+	if slices.Contains(builtinPredicates, name) {
+		panic("predicate " + name + " is built in")
+	}
+	if q.predicates == nil {
+		q.predicates = map[string]PredicateFunc{}
+	}
+	q.predicates[name] = fn
+}
+
+func predicateArgs(q *Query, steps []QueryPredicateStep) []QueryPredicateArg {
+	var args []QueryPredicateArg
+	for _, step := range steps[1:] {
+		switch step.Type {
+		case QueryPredicateStepTypeCapture:
+			args = append(args, QueryPredicateArg{Type: step.Type, ID: step.ValueId, Value: q.CaptureNameForId(step.ValueId)})
+		case QueryPredicateStepTypeString:
+			args = append(args, QueryPredicateArg{Type: step.Type, ID: step.ValueId, Value: q.StringValueForId(step.ValueId)})
+		}
+	}
+	return args
+}
+
+// CaptureNameForId returns the name of the capture with the given id, or "" if there is none.
+// Names are resolved when the query is created, so this doesn't allocate.
 func (q *Query) CaptureNameForId(id int) string {
-	var length C.uint32_t
-	name := C.ts_query_capture_name_for_id(q.c, C.uint32_t(id), &length)
-	return C.GoStringN(name, C.int(length))
+	if id < 0 || id >= len(q.captureNames) {
+		return ""
+	}
+	return q.captureNames[id]
 }
 
 func (q *Query) StringValueForId(id int) string {
@@ -984,7 +1764,92 @@ func (q *Query) CaptureQuantifierForId(id uint32, captureId uint32) Quantifier {
 	return Quantifier(C.ts_query_capture_quantifier_for_id(q.c, C.uint32_t(id), C.uint32_t(captureId)))
 }
 
+// StartByteForPattern returns the byte offset where the given pattern starts in the query's source.
+func (q *Query) StartByteForPattern(patternIndex uint32) uint32 {
+	return uint32(C.ts_query_start_byte_for_pattern(q.c, C.uint32_t(patternIndex)))
+}
+
+// EndByteForPattern returns the byte offset where the given pattern ends in the query's source.
+//
+// The C library only records where patterns start, so the end is that of the last
+// non-blank, non-comment line before the next pattern or the end of the source.
+func (q *Query) EndByteForPattern(patternIndex uint32) uint32 {
+	end := len(q.source)
+	if patternIndex+1 < q.PatternCount() {
+		end = int(q.StartByteForPattern(patternIndex + 1))
+	}
+	start := int(q.StartByteForPattern(patternIndex))
+	for end > start {
+		text := bytes.TrimRight(q.source[start:end], " \t\r\n")
+		end = start + len(text)
+		i := bytes.LastIndexByte(text, '\n') + 1
+		if !bytes.HasPrefix(bytes.TrimLeft(text[i:], " \t"), []byte(";")) {
+			break
+		}
+		end = start + i
+	}
+	return uint32(end)
+}
+
+// IsPatternRooted checks if the given pattern in the query has a single root node.
+func (q *Query) IsPatternRooted(patternIndex uint32) bool {
+	return bool(C.ts_query_is_pattern_rooted(q.c, C.uint32_t(patternIndex)))
+}
+
+// IsPatternNonLocal checks if the given pattern in the query is 'non local'.
+//
+// A non-local pattern has multiple root nodes and can match within a
+// repeating sequence of nodes, as specified by the grammar. Non-local
+// patterns disable certain optimizations that would otherwise be possible
+// when executing a query on a specific range of a syntax tree.
+func (q *Query) IsPatternNonLocal(patternIndex uint32) bool {
+	return bool(C.ts_query_is_pattern_non_local(q.c, C.uint32_t(patternIndex)))
+}
+
+// IsPatternGuaranteedAtStep checks if a given pattern is guaranteed to match once a given step is reached.
+// The step is specified by its byte offset in the query's source code.
+func (q *Query) IsPatternGuaranteedAtStep(byteOffset uint32) bool {
+	return bool(C.ts_query_is_pattern_guaranteed_at_step(q.c, C.uint32_t(byteOffset)))
+}
+
+// DisableCapture disables a certain capture within a query.
+//
+// This prevents the capture from being returned in matches, and also avoids
+// any resource usage associated with recording the capture. Currently, there
+// is no way to undo this.
+func (q *Query) DisableCapture(name string) {
+	str := C.CString(name)
+	defer C.free(unsafe.Pointer(str))
+	C.ts_query_disable_capture(q.c, str, C.uint32_t(len(name)))
+}
+
+// DisablePattern disables a certain pattern within a query.
+//
+// This prevents the pattern from matching and removes most of the overhead
+// associated with the pattern. Currently, there is no way to undo this.
+func (q *Query) DisablePattern(patternIndex uint32) {
+	C.ts_query_disable_pattern(q.c, C.uint32_t(patternIndex))
+}
+
+// QueryAssertion is a property asserted by a pattern with #is?, or negated with #is-not?,
+// such as (#is-not? local). Their meaning is left to the consumers of the query.
+type QueryAssertion struct {
+	Key      string
+	Value    string // "" if the assertion has no value
+	Positive bool   // false for #is-not?
+}
+
+// Assertions returns the #is? and #is-not? assertions of the given pattern.
+func (q *Query) Assertions(patternIndex uint32) []QueryAssertion {
+	return slices.Clone(q.assertions[patternIndex])
+}
+
 // QueryCursor carries the state needed for processing the queries.
+//
+// A cursor can be reused for any number of queries: each call to Exec starts over,
+// though ranges set with SetPointRange stay in effect. Code executing many queries
+// can take cursors from a QueryCursorPool instead of creating one for each.
+// A cursor must not be used by several goroutines at once.
 type QueryCursor struct {
 	c *C.TSQueryCursor
 	// keep a pointer to the query to avoid garbage collection
@@ -1006,6 +1871,12 @@ func (qc *QueryCursor) Exec(q *Query, n Node) {
 	C.ts_query_cursor_exec(qc.c, q.c, n.c)
 }
 
+// SetByteRange restricts the matches of the query cursor to the nodes intersecting
+// the given range of bytes.
+func (qc *QueryCursor) SetByteRange(start, end int) {
+	C.ts_query_cursor_set_byte_range(qc.c, C.uint32_t(start), C.uint32_t(end))
+}
+
 func (qc *QueryCursor) SetPointRange(startPoint Point, endPoint Point) {
 	cStartPoint := C.TSPoint{
 		row:    C.uint32_t(startPoint.Row),
@@ -1033,6 +1904,7 @@ func (qc *QueryCursor) Close() {
 type QueryCapture struct {
 	Index int
 	Node  Node
+	Name  string // name of the capture, as returned by CaptureNameForId
 }
 
 // QueryMatch - you can then iterate over the matches.
@@ -1040,6 +1912,77 @@ type QueryMatch struct {
 	ID           int
 	PatternIndex uint16
 	Captures     []QueryCapture
+
+	// The fields below are filled by FilterPredicates from the pattern's directives.
+
+	// Properties holds the key/value pairs set with #set!; a key set without a value maps to "".
+	Properties map[string]string
+	// CaptureRanges holds the ranges of the captures moved with #offset!, by position in Captures,
+	// so that each node of a quantified capture has its own.
+	CaptureRanges map[int]Range
+	// CaptureTexts holds the text of the captures with #strip! applied, by position in Captures.
+	CaptureTexts map[int]string
+	// Assertions holds the #is? and #is-not? assertions of the pattern, as from Query.Assertions.
+	// They are not checked by FilterPredicates.
+	Assertions []QueryAssertion
+
+	// the query the match comes from, to look up the quantifiers of its captures
+	query *Query
+}
+
+// CaptureGroup holds all the nodes a match captured with one capture,
+// which are several for captures quantified with + or *.
+type CaptureGroup struct {
+	Index      int
+	Name       string
+	Quantifier Quantifier // quantifier of the capture in the match's pattern
+	Nodes      []Node
+}
+
+// CapturesByName returns the nodes of the match captured with the given name.
+func (m *QueryMatch) CapturesByName(name string) []Node {
+	var nodes []Node
+	for _, c := range m.Captures {
+		if c.Name == name {
+			nodes = append(nodes, c.Node)
+		}
+	}
+	return nodes
+}
+
+// NodesForCapture returns the nodes of the match captured with the given name, in order,
+// and the capture's quantifier in the match's pattern, telling whether the capture
+// holds a single node or a list of them, possibly empty.
+//
+// The quantifier is QuantifierZero when the pattern has no such capture,
+// and for matches that don't come from a QueryCursor.
+func (m *QueryMatch) NodesForCapture(name string) ([]Node, Quantifier) {
+	var quantifier Quantifier
+	if m.query != nil {
+		if id := slices.Index(m.query.captureNames, name); id >= 0 {
+			quantifier = m.query.CaptureQuantifierForId(uint32(m.PatternIndex), uint32(id))
+		}
+	}
+	return m.CapturesByName(name), quantifier
+}
+
+// CaptureGroups returns the captures of the match grouped by capture,
+// in the order of their first node.
+func (m *QueryMatch) CaptureGroups() []CaptureGroup {
+	var groups []CaptureGroup
+	for _, c := range m.Captures {
+		i := slices.IndexFunc(groups, func(g CaptureGroup) bool { return g.Index == c.Index })
+		if i < 0 {
+			g := CaptureGroup{Index: c.Index, Name: c.Name}
+			if m.query != nil {
+				g.Quantifier = m.query.CaptureQuantifierForId(uint32(m.PatternIndex), uint32(c.Index))
+			}
+			groups = append(groups, g)
+			i = len(groups) - 1
+		}
+		groups[i].Nodes = append(groups[i].Nodes, c.Node)
+	}
+	return groups
 }
 
 // NextMatch iterates over matches.
@@ -1055,12 +1998,13 @@ func (qc *QueryCursor) NextMatch() (*QueryMatch, bool) {
 	qm := &QueryMatch{
 		ID:           int(cqm.id),
 		PatternIndex: uint16(cqm.pattern_index),
+		query:        qc.q,
 	}
 
 	cqc := unsafe.Slice((*C.TSQueryCapture)(cqm.captures), int(cqm.capture_count))
 	for _, c := range cqc {
 		idx := int(c.index)
-		qm.Captures = append(qm.Captures, QueryCapture{idx, Node{c: c.node, t: qc.t}})
+		qm.Captures = append(qm.Captures, QueryCapture{Index: idx, Node: Node{c: c.node, t: qc.t}, Name: qc.q.captureNames[idx]})
 	}
 
 	return qm, true
@@ -1079,17 +2023,60 @@ func (qc *QueryCursor) NextCapture() (*QueryMatch, int, bool) {
 	qm := &QueryMatch{
 		ID:           int(cqm.id),
 		PatternIndex: uint16(cqm.pattern_index),
+		query:        qc.q,
 	}
 
 	cqc := unsafe.Slice((*C.TSQueryCapture)(cqm.captures), int(cqm.capture_count))
 	for _, c := range cqc {
 		idx := int(c.index)
-		qm.Captures = append(qm.Captures, QueryCapture{idx, Node{c: c.node, t: qc.t}})
+		qm.Captures = append(qm.Captures, QueryCapture{Index: idx, Node: Node{c: c.node, t: qc.t}, Name: qc.q.captureNames[idx]})
 	}
 
 	return qm, int(captureIndex), true
 }
 
+// Matches executes q on n and returns an iterator over the matches that satisfy
+// the query's predicates, evaluated against input, the content n was parsed from.
+func (qc *QueryCursor) Matches(q *Query, n Node, input []byte) iter.Seq[*QueryMatch] {
+	return func(yield func(*QueryMatch) bool) {
+		qc.Exec(q, n)
+		for {
+			m, ok := qc.NextMatch()
+			if !ok {
+				return
+			}
+			if m, ok = qc.filterPredicates(m, input); ok && !yield(m) {
+				return
+			}
+		}
+	}
+}
+
+// Captures executes q on n and returns an iterator over the captures in the order
+// of their nodes in the source, as with NextCapture, rather than grouped by match.
+// It yields each capture's match, filtered by the query's predicates evaluated against input,
+// and the index of the capture in the match's Captures.
+// Matches failing the predicates are dropped, along with all their captures.
+func (qc *QueryCursor) Captures(q *Query, n Node, input []byte) iter.Seq2[*QueryMatch, int] {
+	return func(yield func(*QueryMatch, int) bool) {
+		qc.Exec(q, n)
+		for {
+			m, idx, ok := qc.NextCapture()
+			if !ok {
+				return
+			}
+			m, ok = qc.filterPredicates(m, input)
+			if !ok {
+				C.ts_query_cursor_remove_match(qc.c, C.uint32_t(m.ID))
+				continue
+			}
+			if !yield(m, idx) {
+				return
+			}
+		}
+	}
+}
+
 // Copied From: https://github.com/klothoplatform/go-tree-sitter/commit/e351b20167b26d515627a4a1a884528ede5fef79
 
 func splitPredicates(steps []QueryPredicateStep) [][]QueryPredicateStep {
@@ -1106,17 +2093,23 @@ func splitPredicates(steps []QueryPredicateStep) [][]QueryPredicateStep {
 }
 
 func (qc *QueryCursor) FilterPredicates(m *QueryMatch, input []byte) *QueryMatch {
-	qm := &QueryMatch{
-		ID:           m.ID,
-		PatternIndex: m.PatternIndex,
-	}
+	qm, _ := qc.filterPredicates(m, input)
+	return qm
+}
 
+// filterPredicates is FilterPredicates, also reporting whether m satisfied the predicates.
+func (qc *QueryCursor) filterPredicates(m *QueryMatch, input []byte) (*QueryMatch, bool) {
 	q := qc.q
 
-	predicates := q.PredicatesForPattern(uint32(qm.PatternIndex))
+	predicates := q.patternPredicates[m.PatternIndex]
 	if len(predicates) == 0 {
-		qm.Captures = m.Captures
-		return qm
+		return m, true
+	}
+
+	qm := &QueryMatch{
+		ID:           m.ID,
+		PatternIndex: m.PatternIndex,
+		query:        q,
 	}
 
 	// track if we matched all predicates globally
@@ -1127,81 +2120,122 @@ func (qc *QueryCursor) FilterPredicates(m *QueryMatch, input []byte) *QueryMatch
 		operator := q.StringValueForId(steps[0].ValueId)
 
 		switch operator {
-		case "eq?", "not-eq?":
-			isPositive := operator == "eq?"
-
-			expectedCaptureNameLeft := q.CaptureNameForId(steps[1].ValueId)
+		case "eq?", "not-eq?", "any-eq?", "any-not-eq?":
+			isPositive := operator == "eq?" || operator == "any-eq?"
+			matchAll := !strings.HasPrefix(operator, "any-")
 
+			left := capturedNodes(q, m, q.CaptureNameForId(steps[1].ValueId))
 			if steps[2].Type == QueryPredicateStepTypeCapture {
-				expectedCaptureNameRight := q.CaptureNameForId(steps[2].ValueId)
-
-				var nodeLeft, nodeRight Node
-
-				for _, c := range m.Captures {
-					captureName := q.CaptureNameForId(c.Index)
-
-					if captureName == expectedCaptureNameLeft {
-						nodeLeft = c.Node
-					}
-					if captureName == expectedCaptureNameRight {
-						nodeRight = c.Node
-					}
-
-					if nodeLeft != (Node{}) && nodeRight != (Node{}) {
-						eq := bytes.Equal(nodeContent(nodeLeft, input), nodeContent(nodeRight, input))
-						if eq != isPositive {
-							matchedAll = false
-						}
-						break
-					}
+				right := capturedNodes(q, m, q.CaptureNameForId(steps[2].ValueId))
+				if !testNodePairs(left, right, matchAll, func(l, r Node) bool {
+					return bytes.Equal(nodeContent(l, input), nodeContent(r, input)) == isPositive
+				}) {
+					matchedAll = false
 				}
 			} else {
-				expectedValueRight := q.StringValueForId(steps[2].ValueId)
-
-				for _, c := range m.Captures {
-					captureName := q.CaptureNameForId(c.Index)
-
-					if expectedCaptureNameLeft != captureName {
-						continue
-					}
-
-					// TODO: make a version of StringValueForId that doesn't allocate
-					if (string(nodeContent(c.Node, input)) == expectedValueRight) != isPositive {
-						matchedAll = false
-						break
-					}
+				expected := []byte(q.StringValueForId(steps[2].ValueId))
+				if !testNodes(left, matchAll, func(n Node) bool {
+					return bytes.Equal(nodeContent(n, input), expected) == isPositive
+				}) {
+					matchedAll = false
 				}
 			}
 
-			if matchedAll == false {
-				break
-			}
+		case "match?", "not-match?", "any-match?", "any-not-match?":
+			isPositive := operator == "match?" || operator == "any-match?"
+			matchAll := !strings.HasPrefix(operator, "any-")
 
-		case "match?", "not-match?":
-			isPositive := operator == "match?"
+			regex := q.regexes[steps[2].ValueId]
+			nodes := capturedNodes(q, m, q.CaptureNameForId(steps[1].ValueId))
+			if !testNodes(nodes, matchAll, func(n Node) bool {
+				return regex.Match(nodeContent(n, input)) == isPositive
+			}) {
+				matchedAll = false
+			}
 
-			expectedCaptureName := q.CaptureNameForId(steps[1].ValueId)
-			regex := regexp.MustCompile(q.StringValueForId(steps[2].ValueId))
+		case "any-of?", "not-any-of?":
+			isPositive := operator == "any-of?"
 
-			for _, c := range m.Captures {
-				captureName := q.CaptureNameForId(c.Index)
-				if expectedCaptureName != captureName {
-					continue
+			values := predicateStrings(q, steps[2:])
+			for _, n := range capturedNodes(q, m, q.CaptureNameForId(steps[1].ValueId)) {
+				if slices.Contains(values, string(nodeContent(n, input))) != isPositive {
+					matchedAll = false
+					break
 				}
+			}
 
-				if regex.Match(nodeContent(c.Node, input)) != isPositive {
+		case "contains?":
+			values := predicateStrings(q, steps[2:])
+			for _, n := range capturedNodes(q, m, q.CaptureNameForId(steps[1].ValueId)) {
+				content := nodeContent(n, input)
+				if !slices.ContainsFunc(values, func(v string) bool { return bytes.Contains(content, []byte(v)) }) {
 					matchedAll = false
 					break
 				}
 			}
+
+		default:
+			if fn := q.predicates[operator]; fn != nil && !fn(predicateArgs(q, steps), m, input) {
+				matchedAll = false
+			}
 		}
 	}
 
 	if matchedAll {
 		qm.Captures = append(qm.Captures, m.Captures...)
+		qm.Assertions = q.Assertions(uint32(qm.PatternIndex))
+		applyDirectives(q, qm, predicates, input)
 	}
 
-	return qm
+	return qm, matchedAll
+}
+
+// testNodes reports whether test holds for all the nodes if matchAll is set,
+// and for any of them otherwise. It holds for a capture that captured no nodes.
+func testNodes(nodes []Node, matchAll bool, test func(Node) bool) bool {
+	if len(nodes) == 0 {
+		return true
+	}
+	if matchAll {
+		return !slices.ContainsFunc(nodes, func(n Node) bool { return !test(n) })
+	}
+	return slices.ContainsFunc(nodes, test)
+}
+
+// testNodePairs is testNodes for the pairs of nodes at the same position in left and right,
+// as when comparing two captures. As in tree-sitter, when no pair decides the result,
+// it holds if the captures have as many nodes, whether or not matchAll is set.
+func testNodePairs(left, right []Node, matchAll bool, test func(l, r Node) bool) bool {
+	n := min(len(left), len(right))
+	for i := range n {
+		if ok := test(left[i], right[i]); ok != matchAll {
+			return ok
+		}
+	}
+	return len(left) == len(right)
+}
+
+// capturedNodes returns the nodes of m captured with the given name.
+func capturedNodes(q *Query, m *QueryMatch, name string) []Node {
+	var nodes []Node
+	for _, c := range m.Captures {
+		if q.CaptureNameForId(c.Index) == name {
+			nodes = append(nodes, c.Node)
+		}
+	}
+	return nodes
+}
+
+// predicateStrings returns the values of the string arguments in steps, up to the done step.
+func predicateStrings(q *Query, steps []QueryPredicateStep) []string {
+	var values []string
+	for _, step := range steps {
+		if step.Type != QueryPredicateStepTypeString {
+			break
+		}
+		values = append(values, q.StringValueForId(step.ValueId))
+	}
+	return values
 }
 
 func nodeContent(n Node, b []byte) []byte { return b[n.StartByte():n.EndByte()] }
@@ -3,12 +3,16 @@ package treesitter
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"runtime"
 	"strconv"
 	"strings"
 	"sync"
 	"testing"
 	"time"
+	"unsafe"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,6 +35,8 @@ func TestRootNode(t *testing.T) {
 	assert.Equal("(expression (sum left: (expression (number)) right: (expression (number))))", n.String())
 	assert.Equal("expression", n.Type())
 	assert.Equal(Symbol(7), n.Symbol())
+	assert.Equal(Symbol(7), n.GrammarSymbol())
+	assert.Equal("expression", n.GrammarType())
 
 	assert.Equal(false, n.IsNull())
 	assert.Equal(true, n.IsNamed())
@@ -103,6 +109,207 @@ func TestTree(t *testing.T) {
 	descendantNode := n.NamedDescendantForPointRange(Point{Row: 0, Column: 5}, Point{Row: 0, Column: 11})
 	assert.NotNil(descendantNode, "Descendant node was nil")
 	assert.Equal("(3 + 3)", string(nodeContent(descendantNode, newText)))
+
+	descendantNode = n.DescendantForPointRange(Point{Row: 0, Column: 4}, Point{Row: 0, Column: 5})
+	assert.Equal("(", descendantNode.Type())
+	assert.False(descendantNode.IsNamed())
+}
+
+func TestApplyEdits(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+
+	// change 1 -> 10 and 2 -> (3 + 3), out of order
+	ranges, err := tree.ApplyEdits([]EditInput{
+		{
+			StartIndex:  4,
+			OldEndIndex: 5,
+			NewEndIndex: 11,
+			StartPoint:  Point{Row: 0, Column: 4},
+			OldEndPoint: Point{Row: 0, Column: 5},
+			NewEndPoint: Point{Row: 0, Column: 11},
+		},
+		{
+			StartIndex:  0,
+			OldEndIndex: 1,
+			NewEndIndex: 2,
+			StartPoint:  Point{Row: 0, Column: 0},
+			OldEndPoint: Point{Row: 0, Column: 1},
+			NewEndPoint: Point{Row: 0, Column: 2},
+		},
+	})
+	assert.NoError(err)
+	assert.Equal([]Range{
+		{StartPoint: Point{0, 0}, EndPoint: Point{0, 2}, StartByte: 0, EndByte: 2},
+		{StartPoint: Point{0, 5}, EndPoint: Point{0, 12}, StartByte: 5, EndByte: 12},
+	}, ranges)
+
+	newText := []byte("10 + (3 + 3)")
+	newTree, err := parser.Parse(context.Background(), tree, newText)
+	assert.NoError(err)
+	right := newTree.RootNode().Child(0).ChildByFieldName("right")
+	assert.Equal("(3 + 3)", string(nodeContent(right, newText)))
+	assert.Equal(Point{Row: 0, Column: 12}, right.EndPoint())
+
+	// overlapping edits are rejected without touching the tree
+	_, err = newTree.ApplyEdits([]EditInput{
+		{StartIndex: 0, OldEndIndex: 4, NewEndIndex: 4, OldEndPoint: Point{0, 4}, NewEndPoint: Point{0, 4}},
+		{StartIndex: 2, OldEndIndex: 3, NewEndIndex: 3, StartPoint: Point{0, 2}, OldEndPoint: Point{0, 3}, NewEndPoint: Point{0, 3}},
+	})
+	assert.ErrorIs(err, ErrInvalidEdit)
+	assert.False(newTree.RootNode().HasChanges())
+
+	_, err = newTree.ApplyEdits([]EditInput{{StartIndex: 3, OldEndIndex: 2}})
+	assert.ErrorIs(err, ErrInvalidEdit)
+}
+
+func TestChangedRanges(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+
+	// change 2 -> (3 + 3)
+	tree.Edit(EditInput{
+		StartIndex:  4,
+		OldEndIndex: 5,
+		NewEndIndex: 11,
+		StartPoint:  Point{Row: 0, Column: 4},
+		OldEndPoint: Point{Row: 0, Column: 5},
+		NewEndPoint: Point{Row: 0, Column: 11},
+	})
+	newTree, err := parser.Parse(context.Background(), tree, []byte("1 + (3 + 3)"))
+	assert.NoError(err)
+
+	ranges := tree.ChangedRanges(newTree)
+	assert.Len(ranges, 1)
+	assert.Equal(4, ranges[0].StartByte)
+	assert.Equal(11, ranges[0].EndByte)
+
+	// identical trees have no changes
+	assert.Empty(newTree.ChangedRanges(newTree.Copy()))
+}
+
+func TestPrintDotGraph(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+
+	var buf bytes.Buffer
+	assert.NoError(tree.PrintDotGraph(&buf))
+	out := buf.String()
+	assert.True(strings.HasPrefix(out, "digraph tree {"), out)
+	assert.Contains(out, `label="sum"`)
+	assert.Contains(out, `label="number"`)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestPrintDotGraphWriteError(t *testing.T) {
+	assert := assert.New(t)
+
+	// large enough that the DOT output exceeds the pipe buffer
+	src := []byte(strings.Repeat("1 + ", 20000) + "1")
+	tree, err := NewParser("testlang").Parse(context.Background(), nil, src)
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() { done <- tree.PrintDotGraph(failingWriter{}) }()
+	select {
+	case err := <-done:
+		assert.EqualError(err, "write failed")
+	case <-time.After(10 * time.Second):
+		t.Fatal("PrintDotGraph did not return after a write error")
+	}
+}
+
+func TestFirstChildForByte(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+	sum := root.Child(0)
+
+	assert.Equal("+", sum.FirstChildForByte(2).Type())
+	assert.True(sum.FirstNamedChildForByte(2).Equal(sum.ChildByFieldName("right")))
+	assert.True(sum.FirstChildForByte(0).Equal(sum.ChildByFieldName("left")))
+	assert.True(sum.FirstChildForByte(100).IsNull())
+}
+
+func TestChildNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2 // two"), "testlang")
+	assert.NoError(err)
+	sum := root.Child(0)
+
+	children := sum.ChildNodes()
+	assert.Len(children, sum.ChildCount())
+	for i, c := range children {
+		assert.True(c.Equal(sum.Child(i)))
+	}
+
+	named := sum.NamedChildNodes()
+	assert.Len(named, 2)
+	for i, c := range named {
+		assert.True(c.Equal(sum.NamedChild(i)))
+	}
+
+	assert.Nil(children[0].Child(0).ChildNodes())
+	assert.Len(root.ChildNodes(), root.ChildCount())
+}
+
+func TestParseState(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+	sum := root.Child(0)
+	plus := sum.Child(1)
+
+	number := sum.Child(0).Child(0)
+	assert.Equal("number", number.Type())
+
+	assert.NotZero(plus.ParseState())
+	// the '+' token is parsed in the state reached after the number preceding it
+	assert.Equal(number.NextParseState(), plus.ParseState())
+
+	lang := languages["testlang"]
+	assert.Greater(lang.StateCount(), int(plus.ParseState()))
+	assert.Equal(number.NextParseState(), lang.NextState(number.ParseState(), number.GrammarSymbol()))
+	assert.Equal(plus.NextParseState(), lang.NextState(plus.ParseState(), plus.GrammarSymbol()))
+}
+
+func TestExplainError(t *testing.T) {
+	assert := assert.New(t)
+
+	explain := func(src string) []string {
+		root, err := Parse(context.Background(), []byte(src), "testlang")
+		assert.NoError(err)
+		var msgs []string
+		for n := range root.PreOrder() {
+			if msg := ExplainError(n, []byte(src)); msg != "" {
+				msgs = append(msgs, msg)
+			}
+		}
+		return msgs
+	}
+
+	assert.Nil(explain("1 + 2"))
+	assert.Equal([]string{"expected number, found end of file at 1:4"}, explain("1 +"))
+	assert.Equal([]string{"expected ')', found end of file at 1:7"}, explain("(1 + 2"))
+	assert.Equal([]string{"expected '(', number or variable, found '+' at 1:5"}, explain("1 + + 2"))
+	assert.Equal([]string{"expected '(', number or variable, found ')' at 1:1"}, explain(")"))
+	assert.Equal([]string{"unexpected ')' at 2:2"}, explain("1 + 2 // c\n )"))
+	assert.Equal([]string{"unexpected number at 1:9"}, explain("(1 + (2 3))"))
 }
 
 func TestErrorNodes(t *testing.T) {
@@ -157,6 +364,30 @@ func TestLanguage(t *testing.T) {
 	assert.Equal(SymbolTypeRegular.String(), "Regular")
 }
 
+func TestNewParserFromLanguage(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParserFromLanguage(getTestGrammar())
+	defer parser.Close()
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+	assert.Equal("(expression (sum left: (expression (number)) right: (expression (number))))", tree.RootNode().String())
+}
+
+func TestNewParserChecked(t *testing.T) {
+	assert := assert.New(t)
+
+	parser, err := NewParserChecked("testlang")
+	assert.NoError(err)
+	assert.NotNil(parser)
+
+	parser, err = NewParserChecked("unknown")
+	assert.ErrorIs(err, ErrUnknownLanguage)
+	assert.Nil(parser)
+
+	assert.Panics(func() { NewParser("unknown") })
+}
+
 func TestGC(t *testing.T) {
 	assert := assert.New(t)
 
@@ -242,6 +473,138 @@ func TestContextCancellationParsing(t *testing.T) {
 	assert.NoError(err)
 }
 
+func TestParseNoCopy(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	tree, err := parser.ParseNoCopy(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+	assert.Equal("(expression (sum left: (expression (number)) right: (expression (number))))", tree.RootNode().String())
+
+	tree, err = parser.ParseNoCopy(context.Background(), nil, nil)
+	assert.NoError(err)
+	assert.Equal("(ERROR)", tree.RootNode().String())
+}
+
+func TestParseKeepSource(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	source := []byte("1 + 22")
+	tree, err := parser.ParseKeepSource(context.Background(), nil, source)
+	assert.NoError(err)
+	assert.Equal(source, tree.Source())
+
+	sum := tree.RootNode().Child(0)
+	assert.Equal("1 + 22", sum.Text(nil))
+	assert.Equal("22", sum.ChildByFieldName("right").Text(nil))
+	assert.Equal([]byte("1"), sum.ChildByFieldName("left").Bytes(nil))
+	assert.Equal("22", tree.Copy().RootNode().Child(0).ChildByFieldName("right").Text(nil))
+
+	tree, err = parser.Parse(context.Background(), nil, source)
+	assert.NoError(err)
+	assert.Nil(tree.Source())
+	assert.Equal("", tree.RootNode().Text(nil))
+	assert.Nil(tree.RootNode().Bytes(nil))
+}
+
+func TestNodeText(t *testing.T) {
+	assert := assert.New(t)
+
+	source := []byte("1 + 22")
+	root, err := Parse(context.Background(), source, "testlang")
+	assert.NoError(err)
+
+	right := root.Child(0).ChildByFieldName("right")
+	assert.Equal("22", right.Text(source))
+	assert.Equal([]byte("22"), right.Bytes(source))
+	// out of bounds
+	assert.Equal("", right.Text(source[:5]))
+	assert.Nil(right.Bytes([]byte{}))
+	assert.Equal("", Node{}.Text(source))
+}
+
+func TestParseWithTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	items := []string{}
+	for i := 0; i < 10000; i++ {
+		items = append(items, strconv.Itoa(i))
+	}
+	code := strings.Join(items, " + ")
+
+	tree, err := parser.ParseWithTimeout(nil, []byte(code), time.Microsecond)
+	assert.ErrorIs(err, context.DeadlineExceeded)
+	assert.Nil(tree)
+
+	tree, err = parser.ParseWithDeadline(nil, []byte(code), time.Now().Add(-time.Second))
+	assert.ErrorIs(err, context.DeadlineExceeded)
+	assert.Nil(tree)
+
+	// the parser's own operation limit is left untouched
+	assert.Equal(0, parser.OperationLimit())
+
+	tree, err = parser.ParseWithTimeout(nil, []byte("1 + 1"), time.Minute)
+	assert.NoError(err)
+	assert.Equal("(expression (sum left: (expression (number)) right: (expression (number))))", tree.RootNode().String())
+
+	// nor extended by a longer timeout
+	parser.SetOperationLimit(1)
+	tree, err = parser.ParseWithTimeout(nil, []byte(code), time.Minute)
+	assert.ErrorIs(err, ErrOperationLimit)
+	assert.Nil(tree)
+	assert.Equal(1, parser.OperationLimit())
+
+	// a timeout equal to the limit is still a timeout
+	tree, err = parser.ParseWithTimeout(nil, []byte(code), time.Microsecond)
+	assert.ErrorIs(err, context.DeadlineExceeded)
+	assert.Nil(tree)
+
+	// the version of this grammar is too old for the parser to use it
+	var incompatible [64]uint64
+	incompatible[0] = 1
+	parser = NewParserFromLanguage(&Language{ptr: unsafe.Pointer(&incompatible)})
+	defer parser.Close()
+	tree, err = parser.ParseWithTimeout(nil, []byte(code), time.Minute)
+	assert.ErrorIs(err, ErrNoLanguage)
+	assert.Nil(tree)
+}
+
+func TestParseFiles(t *testing.T) {
+	assert := assert.New(t)
+
+	files := map[string][]byte{
+		"a.calc": []byte("1 + 2"),
+		"b.calc": []byte("3"),
+		"c.txt":  []byte("hello"),
+	}
+	for i := 0; i < 20; i++ {
+		files["gen"+strconv.Itoa(i)+".calc"] = []byte(strconv.Itoa(i) + " + 1")
+	}
+	detect := func(path string) string {
+		if strings.HasSuffix(path, ".calc") {
+			return "testlang"
+		}
+		return ""
+	}
+
+	results := ParseFiles(context.Background(), files, detect, 4)
+	assert.Len(results, len(files))
+	assert.Equal("(expression (sum left: (expression (number)) right: (expression (number))))", results["a.calc"].Tree.RootNode().String())
+	assert.Equal("(expression (number))", results["b.calc"].Tree.RootNode().String())
+	assert.ErrorIs(results["c.txt"].Err, ErrUnknownLanguage)
+	assert.Nil(results["c.txt"].Tree)
+	for i := 0; i < 20; i++ {
+		assert.NoError(results["gen"+strconv.Itoa(i)+".calc"].Err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results = ParseFiles(ctx, files, detect, 0)
+	assert.ErrorIs(results["a.calc"].Err, context.Canceled)
+}
+
 func TestIncludedRanges(t *testing.T) {
 	assert := assert.New(t)
 
@@ -335,6 +698,109 @@ func TestQuery(t *testing.T) {
 	assert.Equal(t, 3, matched)
 }
 
+func TestQueryDisable(t *testing.T) {
+	assert := assert.New(t)
+
+	src := []byte("1 + 2")
+	root, err := Parse(context.Background(), src, "testlang")
+	assert.NoError(err)
+
+	q, err := NewQuery([]byte("(sum left: _* @left right: _* @right) (number) @number"), "testlang")
+	assert.NoError(err)
+	q.DisableCapture("right")
+	q.DisablePattern(1)
+
+	qc := NewQueryCursor()
+	qc.Exec(q, root)
+	var captured []string
+	for {
+		m, ok := qc.NextMatch()
+		if !ok {
+			break
+		}
+		assert.Equal(uint16(0), m.PatternIndex)
+		for _, c := range m.Captures {
+			captured = append(captured, q.CaptureNameForId(c.Index)+"="+c.Node.Text(src))
+		}
+	}
+	assert.Equal([]string{"left=1"}, captured)
+}
+
+func TestQueryPatternBytes(t *testing.T) {
+	assert := assert.New(t)
+
+	src := `; numbers
+(number) @number
+
+; sums
+((sum) @sum
+ (#eq? @sum "1 + 2"))
+  ; trailing comment
+
+(expression) ; inline comment
+`
+	q, err := NewQuery([]byte(src), "testlang")
+	assert.NoError(err)
+	assert.Equal(uint32(3), q.PatternCount())
+
+	var patterns []string
+	for i := range q.PatternCount() {
+		patterns = append(patterns, src[q.StartByteForPattern(i):q.EndByteForPattern(i)])
+	}
+	assert.Equal([]string{
+		"(number) @number",
+		"((sum) @sum\n (#eq? @sum \"1 + 2\"))",
+		"(expression) ; inline comment",
+	}, patterns)
+}
+
+func TestQueryPatternAnalysis(t *testing.T) {
+	assert := assert.New(t)
+
+	src := `(sum left: (expression) right: (expression (number) @n))
+((number) (comment))
+(sum (expression) "+" (expression))`
+	q, err := NewQuery([]byte(src), "testlang")
+	assert.NoError(err)
+
+	assert.True(q.IsPatternRooted(0))
+	assert.False(q.IsPatternNonLocal(0))
+	assert.False(q.IsPatternRooted(1))
+
+	// once a sum's first child is reached, the rest of the pattern must match
+	start := q.StartByteForPattern(2)
+	assert.False(q.IsPatternGuaranteedAtStep(start))
+	assert.True(q.IsPatternGuaranteedAtStep(start + uint32(len("(sum "))))
+	assert.False(q.IsPatternGuaranteedAtStep(uint32(strings.Index(src, "(number) @n"))))
+}
+
+func TestQueryCaptureNames(t *testing.T) {
+	assert := assert.New(t)
+
+	src := []byte("1 + 2")
+	root, err := Parse(context.Background(), src, "testlang")
+	assert.NoError(err)
+
+	q, err := NewQuery([]byte("(sum left: (expression (number) @n) right: (expression (number) @n)) @sum"), "testlang")
+	assert.NoError(err)
+	assert.Equal("n", q.CaptureNameForId(0))
+	assert.Equal("sum", q.CaptureNameForId(1))
+	assert.Equal("", q.CaptureNameForId(2))
+
+	qc := NewQueryCursor()
+	qc.Exec(q, root)
+	m, ok := qc.NextMatch()
+	assert.True(ok)
+	for _, c := range m.Captures {
+		assert.Equal(q.CaptureNameForId(c.Index), c.Name)
+	}
+	assert.Equal([]string{"1", "2"}, []string{m.CapturesByName("n")[0].Text(src), m.CapturesByName("n")[1].Text(src)})
+	assert.Len(m.CapturesByName("sum"), 1)
+	assert.Empty(m.CapturesByName("other"))
+
+	assert.Zero(testing.AllocsPerRun(100, func() { q.CaptureNameForId(1) }))
+}
+
 func testCaptures(t *testing.T, body, sq string, expected []string) {
 	assert := assert.New(t)
 
@@ -446,12 +912,95 @@ func TestTreeCursor(t *testing.T) {
 
 	assert.Equal(int64(2), c.GoToFirstChildForByte(4))
 	assert.Equal("expression", c.CurrentNode().Type())
+	assert.True(c.GoToParent())
+	assert.Equal(int64(1), c.GoToFirstChildForPoint(Point{Row: 0, Column: 2}))
+	assert.Equal("+", c.CurrentNode().Type())
+	assert.True(c.GoToParent())
+	assert.Equal(int64(-1), c.GoToFirstChildForPoint(Point{Row: 1, Column: 0}))
+	assert.Equal(int64(2), c.GoToFirstChildForByte(4))
 
 	c.Reset(nodeForReset)
 	assert.Equal("sum", c.CurrentNode().Type())
 	assert.False(c.GoToParent())
 }
 
+func TestTreeCursorDescendant(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+	c := NewTreeCursor(root)
+
+	assert.Equal(uint32(0), c.CurrentDescendantIndex())
+	c.GoToDescendant(4)
+	assert.Equal("+", c.CurrentNode().Type())
+	assert.Equal(uint32(4), c.CurrentDescendantIndex())
+	assert.Equal(uint32(2), c.CurrentDepth())
+	c.GoToDescendant(6)
+	assert.Equal("number", c.CurrentNode().Type())
+	assert.Equal(4, c.CurrentNode().StartByte())
+	assert.Equal(uint32(3), c.CurrentDepth())
+	assert.True(c.GoToParent())
+	assert.Equal(uint32(5), c.CurrentDescendantIndex())
+	assert.Equal(uint32(2), c.CurrentDepth())
+	c.GoToDescendant(0)
+	assert.True(c.CurrentNode().Equal(root))
+	assert.Equal(uint32(0), c.CurrentDepth())
+}
+
+func TestTreeCursorCopy(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+	c := NewTreeCursor(root)
+	assert.True(c.GoToFirstChild())
+	assert.True(c.GoToFirstChild())
+
+	saved := c.Copy()
+	assert.True(c.GoToNextSibling())
+	assert.Equal("+", c.CurrentNode().Type())
+	assert.Equal("left", saved.CurrentFieldName())
+
+	// the copy keeps its own ancestry
+	assert.True(saved.GoToParent())
+	assert.Equal("sum", saved.CurrentNode().Type())
+
+	c.ResetTo(saved)
+	assert.Equal("sum", c.CurrentNode().Type())
+	assert.Equal(uint32(1), c.CurrentDepth())
+	assert.True(c.GoToParent())
+	assert.True(c.CurrentNode().Equal(root))
+	assert.Equal("sum", saved.CurrentNode().Type())
+}
+
+func TestFieldIDs(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+	lang := languages["testlang"]
+	left := lang.FieldIDForName("left")
+	right := lang.FieldIDForName("right")
+	assert.NotZero(left)
+	assert.NotEqual(left, right)
+	assert.Equal("left", lang.FieldName(int(left)))
+	assert.Zero(lang.FieldIDForName("middle"))
+
+	sum := root.Child(0)
+	assert.Equal(left, sum.FieldIDForChild(0))
+	assert.Zero(sum.FieldIDForChild(1))
+	assert.Equal(right, sum.FieldIDForChild(2))
+	assert.True(sum.ChildByFieldID(right).Equal(sum.ChildByFieldName("right")))
+
+	c := NewTreeCursor(sum)
+	assert.Zero(c.CurrentFieldID())
+	assert.True(c.GoToFirstChild())
+	assert.Equal(left, c.CurrentFieldID())
+	assert.True(c.GoToNextSibling())
+	assert.Zero(c.CurrentFieldID())
+}
+
 func TestLeakParse(t *testing.T) {
 	ctx := context.Background()
 	parser := NewParser("testlang")
@@ -618,6 +1167,37 @@ func BenchmarkParse(b *testing.B) {
 	}
 }
 
+func BenchmarkParseNoCopy(b *testing.B) {
+	ctx := context.Background()
+	parser := NewParser("testlang")
+	inputData := []byte("1 + 2")
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = parser.ParseNoCopy(ctx, nil, inputData)
+	}
+}
+
+func BenchmarkChildNodes(b *testing.B) {
+	root, err := Parse(context.Background(), []byte("1 + 2 + 3 + 4 + 5 + 6 + 7 + 8"), "testlang")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var walk func(n Node)
+	walk = func(n Node) {
+		for _, c := range n.ChildNodes() {
+			walk(c)
+		}
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		walk(root)
+	}
+}
+
 func BenchmarkParseCancellable(b *testing.B) {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
@@ -696,3 +1276,527 @@ func TestNilNodeString(t *testing.T) {
 		t.Errorf("n.String() = %q, want %q", got, want)
 	}
 }
+
+func TestArena(t *testing.T) {
+	assert := assert.New(t)
+
+	arena := NewArena()
+	parser, err := arena.NewParser("testlang")
+	assert.NoError(err)
+	_, err = arena.NewParser("unknown")
+	assert.ErrorIs(err, ErrUnknownLanguage)
+
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+	treeCopy := tree.Copy()
+	cursor := arena.NewTreeCursor(tree.RootNode())
+	assert.True(cursor.GoToFirstChild())
+
+	q, err := arena.NewQuery([]byte("(number) @n"), "testlang")
+	assert.NoError(err)
+	qc := arena.NewQueryCursor()
+	qc.Exec(q, treeCopy.RootNode())
+	_, ok := qc.NextMatch()
+	assert.True(ok)
+
+	arena.Close()
+	assert.Nil(parser.c)
+	assert.Nil(tree.c)
+	assert.Nil(treeCopy.c)
+	assert.Nil(cursor.c)
+	assert.Nil(q.c)
+	assert.Nil(qc.c)
+
+	// closing twice is harmless
+	arena.Close()
+}
+
+func TestArenaReleasedTrees(t *testing.T) {
+	assert := assert.New(t)
+
+	arena := NewArena()
+	defer arena.Close()
+	parser, err := arena.NewParser("testlang")
+	assert.NoError(err)
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(err)
+	shared := NewSharedTree(tree)
+	for range 1000 {
+		shared.Do(func(t *Tree) {
+			assert.Equal("expression", t.RootNode().Type())
+		})
+	}
+	for range 1000 {
+		tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+		assert.NoError(err)
+		tree.Close()
+	}
+
+	// only the parser and the shared tree are left
+	assert.Len(arena.owned, 2)
+	shared.Close()
+	assert.Len(arena.owned, 1)
+}
+
+func TestSharedTree(t *testing.T) {
+	parser := NewParser("testlang")
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2"))
+	assert.NoError(t, err)
+	shared := NewSharedTree(tree)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				shared.Do(func(tree *Tree) {
+					doWorkLifetime(t, tree.RootNode())
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	c := shared.Acquire()
+	shared.Release(c)
+	assert.Nil(t, c.c)
+
+	c = shared.Acquire()
+	shared.Close()
+	assert.Nil(t, tree.c)
+	assert.Equal(t, "(expression (sum left: (expression (number)) right: (expression (number))))", c.RootNode().String())
+	shared.Release(c)
+	assert.Panics(t, func() { shared.Acquire() })
+	// closing twice is harmless
+	shared.Close()
+}
+
+func TestMarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	source := []byte("1 +\n2")
+	parser := NewParser("testlang")
+	tree, err := parser.ParseKeepSource(context.Background(), nil, source)
+	assert.NoError(err)
+
+	b, err := tree.MarshalJSON()
+	assert.NoError(err)
+	assert.JSONEq(`{"type": "expression", "startByte": 0, "endByte": 5,
+		"startPoint": {"row": 0, "column": 0}, "endPoint": {"row": 1, "column": 1},
+		"children": [{"type": "sum", "startByte": 0, "endByte": 5,
+			"startPoint": {"row": 0, "column": 0}, "endPoint": {"row": 1, "column": 1},
+			"children": [
+				{"type": "expression", "field": "left", "startByte": 0, "endByte": 1,
+					"startPoint": {"row": 0, "column": 0}, "endPoint": {"row": 0, "column": 1},
+					"children": [{"type": "number", "startByte": 0, "endByte": 1,
+						"startPoint": {"row": 0, "column": 0}, "endPoint": {"row": 0, "column": 1}}]},
+				{"type": "+", "startByte": 2, "endByte": 3,
+					"startPoint": {"row": 0, "column": 2}, "endPoint": {"row": 0, "column": 3}},
+				{"type": "expression", "field": "right", "startByte": 4, "endByte": 5,
+					"startPoint": {"row": 1, "column": 0}, "endPoint": {"row": 1, "column": 1},
+					"children": [{"type": "number", "startByte": 4, "endByte": 5,
+						"startPoint": {"row": 1, "column": 0}, "endPoint": {"row": 1, "column": 1}}]}]}]}`, string(b))
+
+	b, err = tree.RootNode().Child(0).MarshalJSONOptions(JSONOptions{MaxDepth: 1, IncludeText: true})
+	assert.NoError(err)
+	assert.JSONEq(`{"type": "sum", "startByte": 0, "endByte": 5, "text": "1 +\n2",
+		"startPoint": {"row": 0, "column": 0}, "endPoint": {"row": 1, "column": 1},
+		"children": [
+			{"type": "expression", "field": "left", "startByte": 0, "endByte": 1, "text": "1",
+				"startPoint": {"row": 0, "column": 0}, "endPoint": {"row": 0, "column": 1}},
+			{"type": "+", "startByte": 2, "endByte": 3, "text": "+",
+				"startPoint": {"row": 0, "column": 2}, "endPoint": {"row": 0, "column": 3}},
+			{"type": "expression", "field": "right", "startByte": 4, "endByte": 5, "text": "2",
+				"startPoint": {"row": 1, "column": 0}, "endPoint": {"row": 1, "column": 1}}]}`, string(b))
+
+	b, err = json.Marshal(struct{ Node Node }{})
+	assert.NoError(err)
+	assert.Equal(`{"Node":null}`, string(b))
+}
+
+func TestPrettyString(t *testing.T) {
+	assert := assert.New(t)
+
+	source := []byte("1 +\n22 // two")
+	root, err := Parse(context.Background(), source, "testlang")
+	assert.NoError(err)
+
+	assert.Equal(`expression
+  sum
+    left: expression
+      number
+    "+"
+    right: expression
+      number
+  comment`, root.PrettyString(PrettyOptions{}))
+
+	assert.Equal(`sum [0:0 - 1:2] "1 "...
+	left: expression [0:0 - 0:1] "1"
+		number [0:0 - 0:1] "1"
+	right: expression [1:0 - 1:2] "22"
+		number [1:0 - 1:2] "22"`, root.Child(0).PrettyString(PrettyOptions{
+		Indent:     "\t",
+		NamedOnly:  true,
+		Positions:  true,
+		Snippets:   true,
+		Source:     source,
+		MaxSnippet: 2,
+	}))
+
+	assert.Equal("(nil)", Node{}.PrettyString(PrettyOptions{}))
+}
+
+func TestLanguageProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	_, ok := LanguageProfile("testlang")
+	assert.False(ok)
+
+	RegisterProfile("testlang", &Profile{Declarations: []string{"sum"}, Statements: []string{"expression"}})
+	defer delete(profiles, "testlang")
+	p, ok := LanguageProfile("testlang")
+	assert.True(ok)
+	assert.True(p.IsDeclaration("sum"))
+	assert.False(p.IsContainer("sum"))
+	assert.True(p.IsStatement("expression"))
+
+	assert.Panics(func() { RegisterProfile("testlang", &Profile{}) })
+}
+
+func TestLanguageRegistry(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Contains(Languages(), "testlang")
+	l, ok := LookupLanguage("testlang")
+	assert.True(ok)
+	assert.Same(languages["testlang"], l)
+	_, ok = LookupLanguage("tl")
+	assert.False(ok)
+
+	RegisterAlias("tl", "testlang")
+	defer delete(aliases, "tl")
+	l, ok = LookupLanguage("tl")
+	assert.True(ok)
+	assert.Same(languages["testlang"], l)
+	assert.NotContains(Languages(), "tl")
+
+	_, err := NewParserChecked("tl")
+	assert.NoError(err)
+	_, err = NewQuery([]byte("(sum) @sum"), "tl")
+	assert.NoError(err)
+
+	assert.Panics(func() { RegisterAlias("tl", "other") })
+	assert.Panics(func() { RegisterAlias("testlang", "other") })
+	assert.Panics(func() { RegisterLanguage("tl", getTestGrammar()) })
+	_, ok = languages["tl"]
+	assert.False(ok)
+
+	// registering the same grammar again does nothing
+	assert.NotPanics(func() { RegisterLanguage("testlang", getTestGrammar()) })
+	assert.Panics(func() { RegisterLanguage("testlang", &Language{}) })
+}
+
+func TestDetectLanguage(t *testing.T) {
+	assert := assert.New(t)
+
+	_, ok := DetectLanguage("expr.tl")
+	assert.False(ok)
+
+	RegisterExtension(".tl", "unknown", "testlang")
+	defer delete(extensions, ".tl")
+	name, ok := DetectLanguage("dir/EXPR.TL")
+	assert.True(ok)
+	assert.Equal("testlang", name)
+
+	RegisterAlias("expr", "testlang")
+	defer delete(aliases, "expr")
+	RegisterExtension(".tl", "expr")
+	assert.Equal([]string{"expr", "unknown", "testlang"}, extensions[".tl"])
+	name, ok = DetectLanguage("expr.tl")
+	assert.True(ok)
+	assert.Equal("testlang", name)
+
+	defer func(cpp []string) { extensions[".C"] = cpp }(extensions[".C"])
+	RegisterExtension(".C", "testlang")
+	name, ok = DetectLanguage("expr.C")
+	assert.True(ok)
+	assert.Equal("testlang", name)
+	_, ok = DetectLanguage("expr.c")
+	assert.False(ok)
+
+	_, ok = DetectLanguage("Makefile")
+	assert.False(ok)
+	_, ok = DetectLanguage("README")
+	assert.False(ok)
+}
+
+func TestUnregisterLanguage(t *testing.T) {
+	assert := assert.New(t)
+
+	cached := DefaultQueryCache.Len()
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			RegisterLanguage("dynamic", getTestGrammar())
+			_, ok := LookupLanguage("dynamic")
+			assert.True(ok)
+			_, err := DefaultQueryCache.GetOrCompile([]byte(fmt.Sprintf("(number) @n%d", i)), "dynamic")
+			assert.NoError(err)
+		}()
+	}
+	wg.Wait()
+	RegisterProfile("dynamic", &Profile{})
+	assert.Equal(cached+8, DefaultQueryCache.Len())
+
+	UnregisterLanguage("dynamic")
+	_, ok := LookupLanguage("dynamic")
+	assert.False(ok)
+	_, ok = LanguageProfile("dynamic")
+	assert.False(ok)
+	assert.NotContains(Languages(), "dynamic")
+	assert.Equal(cached, DefaultQueryCache.Len())
+	_, err := NewParserChecked("dynamic")
+	assert.ErrorIs(err, ErrUnknownLanguage)
+}
+
+func TestEqualStructure(t *testing.T) {
+	assert := assert.New(t)
+
+	a, err := Parse(context.Background(), []byte("1 + 2"), "testlang")
+	assert.NoError(err)
+	b, err := Parse(context.Background(), []byte("10  +\n  20"), "testlang")
+	assert.NoError(err)
+	c, err := Parse(context.Background(), []byte("1 + (2)"), "testlang")
+	assert.NoError(err)
+
+	assert.True(EqualStructure(a, b))
+	assert.False(EqualStructure(a, c))
+}
+
+func TestDiffNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	diff := func(aSrc, bSrc string) []string {
+		a, err := Parse(context.Background(), []byte(aSrc), "testlang")
+		assert.NoError(err)
+		b, err := Parse(context.Background(), []byte(bSrc), "testlang")
+		assert.NoError(err)
+
+		var res []string
+		for _, d := range DiffNodes(a, b, []byte(aSrc), []byte(bSrc)) {
+			res = append(res, fmt.Sprintf("%s %q %q", d.Kind, d.Old.Text([]byte(aSrc)), d.New.Text([]byte(bSrc))))
+		}
+		return res
+	}
+
+	assert.Empty(diff("1 + 2", "1  +\n\t2"))
+	assert.Equal([]string{`Changed "2" "3"`}, diff("1 + 2", "1 + 3"))
+	assert.Equal([]string{`Changed "2" "(2 + 3)"`}, diff("1 + 2", "1 + (2 + 3)"))
+	assert.Equal([]string{`Inserted "" "// note"`}, diff("1 + 2", "1 + 2 // note"))
+	assert.Equal([]string{`Deleted "// note" ""`}, diff("1 + 2 // note", "1 + 2"))
+}
+
+func TestNodePath(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := NewParser("testlang")
+	tree, err := parser.Parse(context.Background(), nil, []byte("1 + 2 + 3"))
+	assert.NoError(err)
+
+	three := tree.RootNode().Child(0).ChildByFieldName("right").Child(0)
+	path := three.Path()
+	assert.Equal("/sum[0]/right:expression[2]/number[0]", path.String())
+	assert.Equal("/", tree.RootNode().Path().String())
+
+	n, ok := tree.ResolvePath(path)
+	assert.True(ok)
+	assert.True(n.Equal(three))
+
+	// the same node in a different tree
+	src := []byte("(1) +\n 4 + 5")
+	tree2, err := parser.Parse(context.Background(), nil, src)
+	assert.NoError(err)
+	n, ok = tree2.ResolvePath(path)
+	assert.True(ok)
+	assert.Equal("5", n.Text(src))
+
+	tree3, err := parser.Parse(context.Background(), nil, []byte("(1)"))
+	assert.NoError(err)
+	_, ok = tree3.ResolvePath(path)
+	assert.False(ok)
+	_, ok = tree.ResolvePath(NodePath{{Index: 5, Type: "sum"}})
+	assert.False(ok)
+}
+
+func TestQueryCache(t *testing.T) {
+	assert := assert.New(t)
+
+	cache := NewQueryCache()
+	q1, err := cache.GetOrCompile([]byte("(sum) @sum"), "testlang")
+	assert.NoError(err)
+	q2, err := cache.GetOrCompile([]byte("(sum) @sum"), "testlang")
+	assert.NoError(err)
+	assert.Same(q1, q2)
+	assert.Equal(1, cache.Len())
+
+	_, err = cache.GetOrCompile([]byte("(sum"), "testlang")
+	assert.Error(err)
+	_, err = cache.GetOrCompile([]byte("(sum) @sum"), "unknown")
+	assert.ErrorIs(err, ErrUnknownLanguage)
+	assert.Equal(1, cache.Len())
+
+	cache.Evict([]byte("(sum) @sum"), "testlang")
+	assert.Equal(0, cache.Len())
+	q3, err := cache.GetOrCompile([]byte("(sum) @sum"), "testlang")
+	assert.NoError(err)
+	assert.NotSame(q1, q3)
+
+	var wg sync.WaitGroup
+	queries := make([]*Query, 8)
+	for i := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			queries[i], _ = cache.GetOrCompile([]byte("(number) @n"), "testlang")
+		}()
+	}
+	wg.Wait()
+	for _, q := range queries {
+		assert.Same(queries[0], q)
+	}
+
+	cache.Clear()
+	assert.Equal(0, cache.Len())
+}
+
+func TestQueryCaptureGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	src := []byte("1 + 2")
+	root, err := Parse(context.Background(), src, "testlang")
+	assert.NoError(err)
+	q, err := NewQuery([]byte(`(sum (comment)* @comments ((expression) @operands "+"?)+) @sum`), "testlang")
+	assert.NoError(err)
+
+	qc := NewQueryCursor()
+	qc.Exec(q, root)
+	m, ok := qc.NextMatch()
+	assert.True(ok)
+
+	operands, quantifier := m.NodesForCapture("operands")
+	assert.Equal(Quantifier(QuantifierOneOrMore), quantifier)
+	if assert.Len(operands, 2) {
+		assert.Equal("1", operands[0].Text(src))
+		assert.Equal("2", operands[1].Text(src))
+	}
+	comments, quantifier := m.NodesForCapture("comments")
+	assert.Empty(comments)
+	assert.Equal(Quantifier(QuantifierZeroOrMore), quantifier)
+	_, quantifier = m.NodesForCapture("bogus")
+	assert.Equal(Quantifier(QuantifierZero), quantifier)
+
+	groups := m.CaptureGroups()
+	if assert.Len(groups, 2) {
+		assert.Equal("sum", groups[0].Name)
+		assert.Equal(Quantifier(QuantifierOne), groups[0].Quantifier)
+		assert.Len(groups[0].Nodes, 1)
+		assert.Equal("operands", groups[1].Name)
+		assert.Equal(Quantifier(QuantifierOneOrMore), groups[1].Quantifier)
+		assert.Equal(operands, groups[1].Nodes)
+	}
+
+	_, ok = qc.NextMatch()
+	assert.False(ok)
+}
+
+func TestQueryCursorPool(t *testing.T) {
+	assert := assert.New(t)
+
+	src := []byte("1 + 2\n3")
+	root, err := Parse(context.Background(), src, "testlang")
+	assert.NoError(err)
+	q, err := NewQuery([]byte("(number) @n"), "testlang")
+	assert.NoError(err)
+
+	count := func(qc *QueryCursor) int {
+		var n int
+		for range qc.Matches(q, root, src) {
+			n++
+		}
+		return n
+	}
+
+	var pool QueryCursorPool
+	qc := pool.Get()
+	qc.SetPointRange(Point{Row: 1}, Point{Row: 2})
+	assert.Equal(1, count(qc))
+
+	// cursors come back from the pool with their ranges reset
+	pool.Put(qc)
+	assert.Nil(qc.q)
+	assert.Nil(qc.t)
+	assert.Equal(3, count(qc))
+	pool.Put(qc)
+
+	assert.Equal(3, count(pool.Get()))
+}
+
+func TestQueryCursorByteRange(t *testing.T) {
+	assert := assert.New(t)
+
+	src := []byte("1 + 2\n3")
+	root, err := Parse(context.Background(), src, "testlang")
+	assert.NoError(err)
+	q, err := NewQuery([]byte("(number) @n"), "testlang")
+	assert.NoError(err)
+
+	qc := NewQueryCursor()
+	qc.SetByteRange(4, 7)
+	var texts []string
+	for m := range qc.Matches(q, root, src) {
+		texts = append(texts, m.Captures[0].Node.Text(src))
+	}
+	assert.Equal([]string{"2", "3"}, texts)
+}
+
+func TestQueryValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	lang := languages["testlang"]
+	src := `; sums
+(sum
+  left: (expression (number) @left.number)
+  "+" @op
+  right: (_) @right) @sum
+((comment) @c (#eq? @c "(bogus)") (#match? @c "left:"))
+[(number) (variable)] @leaf
+(expression/number)`
+	q, err := NewQuery([]byte(src), "testlang")
+	assert.NoError(err)
+	assert.Empty(q.Validate(lang))
+
+	// names from another grammar
+	other := `(sum left: (binary_expression) "-" @op)
+((number) @n (#eq? @n "x"))
+[(identifier) "+"] ; (comment_block)
+(sum !middle operator: (_))`
+	issues := validateQuery(other, lang, func(offset uint32) uint32 {
+		return uint32(strings.Count(other[:offset], "\n"))
+	})
+	var messages []string
+	for _, i := range issues {
+		name := strings.Split(i.Message, `"`)[1]
+		assert.True(strings.HasPrefix(strings.TrimPrefix(other[i.Offset:], `"`), name), i.String())
+		messages = append(messages, fmt.Sprintf("%d: %s", i.Pattern, i.Message))
+	}
+	assert.Equal([]string{
+		`0: unknown node type "binary_expression"`,
+		`0: unknown anonymous node "-"`,
+		`2: unknown node type "identifier"`,
+		`3: unknown field "middle"`,
+		`3: unknown field "operator"`,
+	}, messages)
+}
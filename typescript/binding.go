@@ -1,15 +1,30 @@
 package typescript
 
+//go:generate go run ../cmd/tsgen -o nodes.go node-types.json
+//go:generate go run ../cmd/tsgen -consts -language typescript -o symbols.go
+
 //#include "parser.h"
 //TSLanguage *tree_sitter_typescript();
 import "C"
 import (
+	_ "embed"
 	"unsafe"
 
 	"github.com/boldsoftware/treesitter"
 )
 
+var language = treesitter.NewLanguage(unsafe.Pointer(C.tree_sitter_typescript()))
+
+//go:embed node-types.json
+var nodeTypes []byte
+
 func init() {
-	ptr := unsafe.Pointer(C.tree_sitter_typescript())
-	treesitter.RegisterLanguage("typescript", treesitter.NewLanguage(ptr))
+	if err := language.SetNodeTypes(nodeTypes); err != nil {
+		panic(err)
+	}
+	treesitter.RegisterLanguage("typescript", language)
+	treesitter.RegisterAlias("ts", "typescript")
 }
+
+// GetLanguage returns the TypeScript language, which is also registered as "typescript".
+func GetLanguage() *treesitter.Language { return language }
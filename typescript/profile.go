@@ -0,0 +1,39 @@
+package typescript
+
+import "github.com/boldsoftware/treesitter"
+
+func init() {
+	treesitter.RegisterProfile("typescript", &treesitter.Profile{
+		Declarations: []string{
+			"function_declaration",
+			"generator_function_declaration",
+			"class_declaration",
+			"abstract_class_declaration",
+			"interface_declaration",
+			"type_alias_declaration",
+			"enum_declaration",
+			"internal_module",
+			"method_definition",
+			"method_signature",
+			"lexical_declaration",
+			"variable_declaration",
+		},
+		Containers: []string{
+			"class_body",
+			"interface_body",
+			"export_statement",
+		},
+		Statements: []string{
+			"expression_statement",
+			"return_statement",
+			"throw_statement",
+			"if_statement",
+			"for_statement",
+			"for_in_statement",
+			"while_statement",
+			"do_statement",
+			"switch_statement",
+			"try_statement",
+		},
+	})
+}
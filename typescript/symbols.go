@@ -0,0 +1,235 @@
+// Code generated by tsgen. DO NOT EDIT.
+
+package typescript
+
+import "github.com/boldsoftware/treesitter"
+
+// Symbols of the named node types, as returned by treesitter.Node.Symbol.
+const (
+	SymIdentifier                         treesitter.Symbol = 1   // identifier
+	SymHashBangLine                       treesitter.Symbol = 2   // hash_bang_line
+	SymGlimmerOpeningTag                  treesitter.Symbol = 47  // glimmer_opening_tag
+	SymGlimmerClosingTag                  treesitter.Symbol = 48  // glimmer_closing_tag
+	SymEscapeSequence                     treesitter.Symbol = 105 // escape_sequence
+	SymComment                            treesitter.Symbol = 106 // comment
+	SymRegexPattern                       treesitter.Symbol = 110 // regex_pattern
+	SymRegexFlags                         treesitter.Symbol = 111 // regex_flags
+	SymNumber                             treesitter.Symbol = 112 // number
+	SymPrivatePropertyIdentifier          treesitter.Symbol = 113 // private_property_identifier
+	SymThis                               treesitter.Symbol = 115 // this
+	SymSuper                              treesitter.Symbol = 116 // super
+	SymTrue                               treesitter.Symbol = 117 // true
+	SymFalse                              treesitter.Symbol = 118 // false
+	SymNull                               treesitter.Symbol = 119 // null
+	SymUndefined                          treesitter.Symbol = 120 // undefined
+	SymStringFragment                     treesitter.Symbol = 161 // string_fragment
+	SymHtmlComment                        treesitter.Symbol = 163 // html_comment
+	SymProgram                            treesitter.Symbol = 166 // program
+	SymExportStatement                    treesitter.Symbol = 167 // export_statement
+	SymNamespaceExport                    treesitter.Symbol = 168 // namespace_export
+	SymExportClause                       treesitter.Symbol = 169 // export_clause
+	SymExportSpecifier                    treesitter.Symbol = 170 // export_specifier
+	SymImport                             treesitter.Symbol = 173 // import
+	SymImportStatement                    treesitter.Symbol = 174 // import_statement
+	SymImportClause                       treesitter.Symbol = 175 // import_clause
+	SymNamespaceImport                    treesitter.Symbol = 177 // namespace_import
+	SymNamedImports                       treesitter.Symbol = 178 // named_imports
+	SymImportSpecifier                    treesitter.Symbol = 179 // import_specifier
+	SymImportAttribute                    treesitter.Symbol = 180 // import_attribute
+	SymExpressionStatement                treesitter.Symbol = 182 // expression_statement
+	SymVariableDeclaration                treesitter.Symbol = 183 // variable_declaration
+	SymLexicalDeclaration                 treesitter.Symbol = 184 // lexical_declaration
+	SymVariableDeclarator                 treesitter.Symbol = 185 // variable_declarator
+	SymStatementBlock                     treesitter.Symbol = 186 // statement_block
+	SymElseClause                         treesitter.Symbol = 187 // else_clause
+	SymIfStatement                        treesitter.Symbol = 188 // if_statement
+	SymSwitchStatement                    treesitter.Symbol = 189 // switch_statement
+	SymForStatement                       treesitter.Symbol = 190 // for_statement
+	SymForInStatement                     treesitter.Symbol = 191 // for_in_statement
+	SymWhileStatement                     treesitter.Symbol = 193 // while_statement
+	SymDoStatement                        treesitter.Symbol = 194 // do_statement
+	SymTryStatement                       treesitter.Symbol = 195 // try_statement
+	SymWithStatement                      treesitter.Symbol = 196 // with_statement
+	SymBreakStatement                     treesitter.Symbol = 197 // break_statement
+	SymContinueStatement                  treesitter.Symbol = 198 // continue_statement
+	SymDebuggerStatement                  treesitter.Symbol = 199 // debugger_statement
+	SymReturnStatement                    treesitter.Symbol = 200 // return_statement
+	SymThrowStatement                     treesitter.Symbol = 201 // throw_statement
+	SymEmptyStatement                     treesitter.Symbol = 202 // empty_statement
+	SymLabeledStatement                   treesitter.Symbol = 203 // labeled_statement
+	SymSwitchBody                         treesitter.Symbol = 204 // switch_body
+	SymSwitchCase                         treesitter.Symbol = 205 // switch_case
+	SymSwitchDefault                      treesitter.Symbol = 206 // switch_default
+	SymCatchClause                        treesitter.Symbol = 207 // catch_clause
+	SymFinallyClause                      treesitter.Symbol = 208 // finally_clause
+	SymParenthesizedExpression            treesitter.Symbol = 209 // parenthesized_expression
+	SymYieldExpression                    treesitter.Symbol = 212 // yield_expression
+	SymObject                             treesitter.Symbol = 213 // object
+	SymObjectPattern                      treesitter.Symbol = 214 // object_pattern
+	SymAssignmentPattern                  treesitter.Symbol = 215 // assignment_pattern
+	SymObjectAssignmentPattern            treesitter.Symbol = 216 // object_assignment_pattern
+	SymArray                              treesitter.Symbol = 217 // array
+	SymArrayPattern                       treesitter.Symbol = 218 // array_pattern
+	SymGlimmerTemplate                    treesitter.Symbol = 219 // glimmer_template
+	SymNestedIdentifier                   treesitter.Symbol = 220 // nested_identifier
+	SymClass                              treesitter.Symbol = 221 // class
+	SymClassDeclaration                   treesitter.Symbol = 222 // class_declaration
+	SymClassHeritage                      treesitter.Symbol = 223 // class_heritage
+	SymFunctionExpression                 treesitter.Symbol = 224 // function_expression
+	SymFunctionDeclaration                treesitter.Symbol = 225 // function_declaration
+	SymGeneratorFunction                  treesitter.Symbol = 226 // generator_function
+	SymGeneratorFunctionDeclaration       treesitter.Symbol = 227 // generator_function_declaration
+	SymArrowFunction                      treesitter.Symbol = 228 // arrow_function
+	SymOptionalChain                      treesitter.Symbol = 231 // optional_chain
+	SymCallExpression                     treesitter.Symbol = 232 // call_expression
+	SymNewExpression                      treesitter.Symbol = 233 // new_expression
+	SymAwaitExpression                    treesitter.Symbol = 234 // await_expression
+	SymMemberExpression                   treesitter.Symbol = 235 // member_expression
+	SymSubscriptExpression                treesitter.Symbol = 236 // subscript_expression
+	SymAssignmentExpression               treesitter.Symbol = 237 // assignment_expression
+	SymAugmentedAssignmentExpression      treesitter.Symbol = 239 // augmented_assignment_expression
+	SymSpreadElement                      treesitter.Symbol = 242 // spread_element
+	SymTernaryExpression                  treesitter.Symbol = 243 // ternary_expression
+	SymBinaryExpression                   treesitter.Symbol = 244 // binary_expression
+	SymUnaryExpression                    treesitter.Symbol = 245 // unary_expression
+	SymUpdateExpression                   treesitter.Symbol = 246 // update_expression
+	SymSequenceExpression                 treesitter.Symbol = 247 // sequence_expression
+	SymString                             treesitter.Symbol = 248 // string
+	SymTemplateString                     treesitter.Symbol = 249 // template_string
+	SymTemplateSubstitution               treesitter.Symbol = 250 // template_substitution
+	SymRegex                              treesitter.Symbol = 251 // regex
+	SymMetaProperty                       treesitter.Symbol = 252 // meta_property
+	SymArguments                          treesitter.Symbol = 253 // arguments
+	SymDecorator                          treesitter.Symbol = 254 // decorator
+	SymClassBody                          treesitter.Symbol = 257 // class_body
+	SymFormalParameters                   treesitter.Symbol = 258 // formal_parameters
+	SymClassStaticBlock                   treesitter.Symbol = 259 // class_static_block
+	SymRestPattern                        treesitter.Symbol = 261 // rest_pattern
+	SymMethodDefinition                   treesitter.Symbol = 262 // method_definition
+	SymPair                               treesitter.Symbol = 263 // pair
+	SymPairPattern                        treesitter.Symbol = 264 // pair_pattern
+	SymComputedPropertyName               treesitter.Symbol = 266 // computed_property_name
+	SymPublicFieldDefinition              treesitter.Symbol = 267 // public_field_definition
+	SymNonNullExpression                  treesitter.Symbol = 269 // non_null_expression
+	SymMethodSignature                    treesitter.Symbol = 270 // method_signature
+	SymAbstractMethodSignature            treesitter.Symbol = 271 // abstract_method_signature
+	SymFunctionSignature                  treesitter.Symbol = 272 // function_signature
+	SymTypeAssertion                      treesitter.Symbol = 273 // type_assertion
+	SymAsExpression                       treesitter.Symbol = 274 // as_expression
+	SymSatisfiesExpression                treesitter.Symbol = 275 // satisfies_expression
+	SymInstantiationExpression            treesitter.Symbol = 276 // instantiation_expression
+	SymImportRequireClause                treesitter.Symbol = 277 // import_require_clause
+	SymExtendsClause                      treesitter.Symbol = 278 // extends_clause
+	SymImplementsClause                   treesitter.Symbol = 280 // implements_clause
+	SymAmbientDeclaration                 treesitter.Symbol = 281 // ambient_declaration
+	SymAbstractClassDeclaration           treesitter.Symbol = 282 // abstract_class_declaration
+	SymModule                             treesitter.Symbol = 283 // module
+	SymInternalModule                     treesitter.Symbol = 284 // internal_module
+	SymImportAlias                        treesitter.Symbol = 286 // import_alias
+	SymNestedTypeIdentifier               treesitter.Symbol = 287 // nested_type_identifier
+	SymInterfaceDeclaration               treesitter.Symbol = 288 // interface_declaration
+	SymExtendsTypeClause                  treesitter.Symbol = 289 // extends_type_clause
+	SymEnumDeclaration                    treesitter.Symbol = 290 // enum_declaration
+	SymEnumBody                           treesitter.Symbol = 291 // enum_body
+	SymEnumAssignment                     treesitter.Symbol = 292 // enum_assignment
+	SymTypeAliasDeclaration               treesitter.Symbol = 293 // type_alias_declaration
+	SymAccessibilityModifier              treesitter.Symbol = 294 // accessibility_modifier
+	SymOverrideModifier                   treesitter.Symbol = 295 // override_modifier
+	SymRequiredParameter                  treesitter.Symbol = 296 // required_parameter
+	SymOptionalParameter                  treesitter.Symbol = 297 // optional_parameter
+	SymOmittingTypeAnnotation             treesitter.Symbol = 299 // omitting_type_annotation
+	SymAddingTypeAnnotation               treesitter.Symbol = 300 // adding_type_annotation
+	SymOptingTypeAnnotation               treesitter.Symbol = 301 // opting_type_annotation
+	SymTypeAnnotation                     treesitter.Symbol = 302 // type_annotation
+	SymAsserts                            treesitter.Symbol = 305 // asserts
+	SymAssertsAnnotation                  treesitter.Symbol = 306 // asserts_annotation
+	SymOptionalType                       treesitter.Symbol = 310 // optional_type
+	SymRestType                           treesitter.Symbol = 311 // rest_type
+	SymConstructorType                    treesitter.Symbol = 313 // constructor_type
+	SymTemplateType                       treesitter.Symbol = 315 // template_type
+	SymTemplateLiteralType                treesitter.Symbol = 316 // template_literal_type
+	SymInferType                          treesitter.Symbol = 317 // infer_type
+	SymConditionalType                    treesitter.Symbol = 318 // conditional_type
+	SymGenericType                        treesitter.Symbol = 319 // generic_type
+	SymTypePredicate                      treesitter.Symbol = 320 // type_predicate
+	SymTypePredicateAnnotation            treesitter.Symbol = 321 // type_predicate_annotation
+	SymTypeQuery                          treesitter.Symbol = 326 // type_query
+	SymIndexTypeQuery                     treesitter.Symbol = 327 // index_type_query
+	SymLookupType                         treesitter.Symbol = 328 // lookup_type
+	SymMappedTypeClause                   treesitter.Symbol = 329 // mapped_type_clause
+	SymLiteralType                        treesitter.Symbol = 330 // literal_type
+	SymExistentialType                    treesitter.Symbol = 332 // existential_type
+	SymFlowMaybeType                      treesitter.Symbol = 333 // flow_maybe_type
+	SymParenthesizedType                  treesitter.Symbol = 334 // parenthesized_type
+	SymPredefinedType                     treesitter.Symbol = 335 // predefined_type
+	SymTypeArguments                      treesitter.Symbol = 336 // type_arguments
+	SymObjectType                         treesitter.Symbol = 337 // object_type
+	SymCallSignature                      treesitter.Symbol = 338 // call_signature
+	SymPropertySignature                  treesitter.Symbol = 339 // property_signature
+	SymTypeParameters                     treesitter.Symbol = 340 // type_parameters
+	SymTypeParameter                      treesitter.Symbol = 341 // type_parameter
+	SymDefaultType                        treesitter.Symbol = 342 // default_type
+	SymConstraint                         treesitter.Symbol = 343 // constraint
+	SymConstructSignature                 treesitter.Symbol = 344 // construct_signature
+	SymIndexSignature                     treesitter.Symbol = 345 // index_signature
+	SymArrayType                          treesitter.Symbol = 346 // array_type
+	SymTupleType                          treesitter.Symbol = 347 // tuple_type
+	SymReadonlyType                       treesitter.Symbol = 348 // readonly_type
+	SymUnionType                          treesitter.Symbol = 349 // union_type
+	SymIntersectionType                   treesitter.Symbol = 350 // intersection_type
+	SymFunctionType                       treesitter.Symbol = 351 // function_type
+	SymInterfaceBody                      treesitter.Symbol = 377 // interface_body
+	SymPropertyIdentifier                 treesitter.Symbol = 378 // property_identifier
+	SymShorthandPropertyIdentifier        treesitter.Symbol = 379 // shorthand_property_identifier
+	SymShorthandPropertyIdentifierPattern treesitter.Symbol = 380 // shorthand_property_identifier_pattern
+	SymStatementIdentifier                treesitter.Symbol = 381 // statement_identifier
+	SymThisType                           treesitter.Symbol = 382 // this_type
+	SymTypeIdentifier                     treesitter.Symbol = 383 // type_identifier
+)
+
+// Fields, as returned by treesitter.Node.FieldIDForChild.
+const (
+	FieldAlias          treesitter.FieldID = 1  // alias
+	FieldAlternative    treesitter.FieldID = 2  // alternative
+	FieldArgument       treesitter.FieldID = 3  // argument
+	FieldArguments      treesitter.FieldID = 4  // arguments
+	FieldBody           treesitter.FieldID = 5  // body
+	FieldCloseTag       treesitter.FieldID = 6  // close_tag
+	FieldCondition      treesitter.FieldID = 7  // condition
+	FieldConsequence    treesitter.FieldID = 8  // consequence
+	FieldConstraint     treesitter.FieldID = 9  // constraint
+	FieldConstructor    treesitter.FieldID = 10 // constructor
+	FieldContent        treesitter.FieldID = 11 // content
+	FieldDeclaration    treesitter.FieldID = 12 // declaration
+	FieldDecorator      treesitter.FieldID = 13 // decorator
+	FieldFinalizer      treesitter.FieldID = 14 // finalizer
+	FieldFlags          treesitter.FieldID = 15 // flags
+	FieldFunction       treesitter.FieldID = 16 // function
+	FieldHandler        treesitter.FieldID = 17 // handler
+	FieldIncrement      treesitter.FieldID = 18 // increment
+	FieldIndex          treesitter.FieldID = 19 // index
+	FieldIndexType      treesitter.FieldID = 20 // index_type
+	FieldInitializer    treesitter.FieldID = 21 // initializer
+	FieldKey            treesitter.FieldID = 22 // key
+	FieldKind           treesitter.FieldID = 23 // kind
+	FieldLabel          treesitter.FieldID = 24 // label
+	FieldLeft           treesitter.FieldID = 25 // left
+	FieldModule         treesitter.FieldID = 26 // module
+	FieldName           treesitter.FieldID = 27 // name
+	FieldObject         treesitter.FieldID = 28 // object
+	FieldOpenTag        treesitter.FieldID = 29 // open_tag
+	FieldOperator       treesitter.FieldID = 30 // operator
+	FieldOptionalChain  treesitter.FieldID = 31 // optional_chain
+	FieldParameter      treesitter.FieldID = 32 // parameter
+	FieldParameters     treesitter.FieldID = 33 // parameters
+	FieldPattern        treesitter.FieldID = 34 // pattern
+	FieldProperty       treesitter.FieldID = 35 // property
+	FieldReturnType     treesitter.FieldID = 36 // return_type
+	FieldRight          treesitter.FieldID = 37 // right
+	FieldSign           treesitter.FieldID = 38 // sign
+	FieldSource         treesitter.FieldID = 39 // source
+	FieldType           treesitter.FieldID = 40 // type
+	FieldTypeArguments  treesitter.FieldID = 41 // type_arguments
+	FieldTypeParameters treesitter.FieldID = 42 // type_parameters
+	FieldValue          treesitter.FieldID = 43 // value
+)
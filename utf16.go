@@ -0,0 +1,90 @@
+package treesitter
+
+import (
+	"bytes"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// LSPPosition is a position in a document as in the Language Server Protocol: a line and
+// a character counted in the code units of the negotiated encoding, UTF-16 by default.
+type LSPPosition struct {
+	Line      uint32 `json:"line"`
+	Character uint32 `json:"character"`
+}
+
+// LSPRange is a range of a document as in the Language Server Protocol, with its end excluded.
+type LSPRange struct {
+	Start LSPPosition `json:"start"`
+	End   LSPPosition `json:"end"`
+}
+
+// UTF16Column returns p's column in UTF-16 code units, as used by LSP positions.
+// line holds the bytes of row p.Row; its content past p.Column is ignored.
+func (p Point) UTF16Column(line []byte) int {
+	return utf16Len(line[:min(p.Column, len(line))])
+}
+
+// PointFromUTF16 converts an LSP position, a row and a column in UTF-16 code units,
+// to a Point with a byte column. line holds the bytes of the row.
+//
+// A column past the end of the line is clamped to the line's length,
+// and one in the middle of a surrogate pair maps to the start of the character.
+func PointFromUTF16(row, column int, line []byte) Point {
+	off := 0
+	for column > 0 && off < len(line) {
+		r, size := utf8.DecodeRune(line[off:])
+		n := utf16.RuneLen(r)
+		if n < 0 {
+			n = 1
+		}
+		if n > column {
+			break
+		}
+		column -= n
+		off += size
+	}
+	return Point{Row: row, Column: off}
+}
+
+// StartPointUTF16 returns the node's start position with the column in UTF-16 code units.
+// src is the content the tree was parsed from; if it is nil, the source retained
+// by ParseKeepSource is used. Without a source, the byte position is returned.
+func (n Node) StartPointUTF16(src []byte) Point {
+	return n.utf16Point(src, n.StartByte(), n.StartPoint())
+}
+
+// EndPointUTF16 returns the node's end position with the column in UTF-16 code units.
+// src is handled as in StartPointUTF16.
+func (n Node) EndPointUTF16(src []byte) Point {
+	return n.utf16Point(src, n.EndByte(), n.EndPoint())
+}
+
+func (n Node) utf16Point(src []byte, offset int, p Point) Point {
+	if src == nil && n.t != nil {
+		src = n.t.source
+	}
+	if offset > len(src) || p.Column > offset {
+		return p
+	}
+	line := src[offset-p.Column : offset]
+	if bytes.IndexByte(line, '\n') >= 0 {
+		// the point doesn't match src
+		return p
+	}
+	return Point{Row: p.Row, Column: utf16Len(line)}
+}
+
+func utf16Len(b []byte) int {
+	n := 0
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		if l := utf16.RuneLen(r); l > 0 {
+			n += l
+		} else {
+			n++
+		}
+		b = b[size:]
+	}
+	return n
+}
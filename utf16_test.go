@@ -0,0 +1,53 @@
+package treesitter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUTF16Positions(t *testing.T) {
+	assert := assert.New(t)
+
+	line := []byte("a é😀 b")
+	assert.Equal(0, Point{Column: 0}.UTF16Column(line))
+	assert.Equal(2, Point{Column: 2}.UTF16Column(line))
+	assert.Equal(3, Point{Column: 4}.UTF16Column(line))
+	assert.Equal(6, Point{Column: 9}.UTF16Column(line))
+	assert.Equal(7, Point{Column: 100}.UTF16Column(line))
+
+	assert.Equal(Point{Row: 1, Column: 4}, PointFromUTF16(1, 3, line))
+	assert.Equal(Point{Row: 1, Column: 9}, PointFromUTF16(1, 6, line))
+	// in the middle of the surrogate pair
+	assert.Equal(Point{Row: 1, Column: 4}, PointFromUTF16(1, 4, line))
+	assert.Equal(Point{Row: 1, Column: len(line)}, PointFromUTF16(1, 100, line))
+
+	for col := range len(line) + 1 {
+		if !utf8RuneStart(line, col) {
+			continue
+		}
+		p := Point{Row: 1, Column: col}
+		assert.Equal(p, PointFromUTF16(1, p.UTF16Column(line), line))
+	}
+
+	src := []byte("1 +\n2 // é😀")
+	tree, err := NewParser("testlang").ParseKeepSource(context.Background(), nil, src)
+	assert.NoError(err)
+	var comment Node
+	for n := range tree.RootNode().PreOrder() {
+		if n.Type() == "comment" {
+			comment = n
+		}
+	}
+	assert.Equal("comment", comment.Type())
+	assert.Equal(Point{Row: 1, Column: 2}, comment.StartPointUTF16(nil))
+	assert.Equal(Point{Row: 1, Column: 8}, comment.EndPointUTF16(nil))
+	assert.Equal(Point{Row: 1, Column: 11}, comment.EndPoint())
+	assert.Equal(Point{Row: 1, Column: 8}, comment.EndPointUTF16(src))
+	assert.Equal(Point{Row: 1, Column: 11}, comment.EndPointUTF16([]byte("1")))
+}
+
+func utf8RuneStart(b []byte, i int) bool {
+	return i == len(b) || b[i]&0xC0 != 0x80
+}
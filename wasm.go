@@ -0,0 +1,81 @@
+//go:build treesitter_wasm
+
+package treesitter
+
+// #cgo CFLAGS: -DTREE_SITTER_FEATURE_WASM
+// #cgo LDFLAGS: -lwasmtime
+// #include <stdlib.h>
+// #include <wasm.h>
+// #include "api.h"
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// Building with the treesitter_wasm tag requires the wasmtime C API to be installed.
+// The standard library grammars are linked against is vendored in wasm/.
+
+var (
+	wasmEngine = sync.OnceValue(func() *C.TSWasmEngine { return (*C.TSWasmEngine)(unsafe.Pointer(C.wasm_engine_new())) })
+
+	// wasmLoader is the store languages are loaded with; parsers get stores of their own.
+	wasmLoaderMu sync.Mutex
+	wasmLoader   *C.TSWasmStore
+)
+
+func init() {
+	newWasmStore = createWasmStore
+}
+
+func createWasmStore() (*C.TSWasmStore, error) {
+	var werr C.TSWasmError
+	store := C.ts_wasm_store_new(wasmEngine(), &werr)
+	if store == nil {
+		return nil, wasmError(werr)
+	}
+	return store, nil
+}
+
+func wasmError(werr C.TSWasmError) error {
+	defer C.free(unsafe.Pointer(werr.message))
+	return errors.New(C.GoString(werr.message))
+}
+
+// LoadLanguageWASM loads a grammar compiled to WebAssembly, such as those built with
+// "tree-sitter build --wasm". Unlike the native code of LoadLanguageFromLibrary,
+// the grammar runs sandboxed, so grammars supplied by users can be loaded safely.
+//
+// Parsers created for the language run it in a WebAssembly store of their own.
+// The language isn't registered; see RegisterLanguage.
+func LoadLanguageWASM(wasm []byte) (*Language, error) {
+	name, err := wasmLanguageName(wasm)
+	if err != nil {
+		return nil, err
+	}
+
+	wasmLoaderMu.Lock()
+	defer wasmLoaderMu.Unlock()
+	if wasmLoader == nil {
+		if wasmLoader, err = createWasmStore(); err != nil {
+			return nil, fmt.Errorf("creating WebAssembly store: %w", err)
+		}
+	}
+
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	cwasm := C.CBytes(wasm)
+	defer C.free(cwasm)
+	var werr C.TSWasmError
+	ptr := C.ts_wasm_store_load_language(wasmLoader, cname, (*C.char)(cwasm), C.uint32_t(len(wasm)), &werr)
+	if ptr == nil {
+		return nil, fmt.Errorf("loading %s: %w", name, wasmError(werr))
+	}
+	if err := checkLanguageVersion(unsafe.Pointer(ptr)); err != nil {
+		return nil, fmt.Errorf("loading %s: %w", name, err)
+	}
+	return NewLanguage(unsafe.Pointer(ptr)), nil
+}
@@ -0,0 +1,33 @@
+# compiles stdlib.ll into wasm-stdlib.h, the WebAssembly module wasm_store.c links
+# the external scanners of grammars against
+#
+# needs LLVM's opt and llc with the WebAssembly target, and wasm-ld; set OPT, LLC and
+# WASM_LD to use other binaries, e.g. WASM_LD="rust-lld -flavor wasm"
+set -e
+cd "$(dirname "$0")"
+
+OPT=${OPT:-opt}
+LLC=${LLC:-llc}
+WASM_LD=${WASM_LD:-wasm-ld}
+
+exports=""
+for symbol in reset_heap __stack_pointer $(tr -d '",' < ../stdlib-symbols.txt); do
+	exports="$exports --export=$symbol"
+done
+
+$OPT -Oz stdlib.ll -o stdlib.bc
+$LLC -O2 -mattr=+mutable-globals -filetype=obj stdlib.bc -o stdlib.o
+$WASM_LD \
+	--no-entry \
+	--stack-first \
+	-z stack-size=65536 \
+	--import-undefined \
+	--import-memory \
+	--import-table \
+	--strip-debug \
+	$exports \
+	stdlib.o -o stdlib.wasm
+xxd -C -i stdlib.wasm > wasm-stdlib.h
+
+# cleanup
+rm stdlib.bc stdlib.o stdlib.wasm
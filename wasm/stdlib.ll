@@ -0,0 +1,682 @@
+; The C standard library functions available to the external scanners of grammars loaded
+; from WebAssembly, along with the heap they allocate from. build-stdlib.sh compiles it
+; to wasm-stdlib.h, which wasm_store.c instantiates in every store; the functions it
+; exports are listed in ../stdlib-symbols.txt.
+;
+; Memory is allocated linearly: free only reclaims the last allocation, and reset_heap,
+; called by the store between parses, discards all of them.
+;
+; The wide character functions classify ASCII and Latin-1 exactly. Beyond them, cases are
+; mapped for Latin Extended-A, Greek and Cyrillic, and code points outside of the blocks
+; of marks, punctuation, symbols and private use are letters.
+
+target datalayout = "e-m:e-p:32:32-p10:8:8-p20:8:8-i64:64-n32:64-S128-ni:1:10:20"
+target triple = "wasm32-unknown-unknown"
+
+@heap_start = internal global i32 0
+@heap_end = internal global i32 0
+@next = internal global i32 0
+
+declare i32 @llvm.wasm.memory.size.i32(i32)
+declare i32 @llvm.wasm.memory.grow.i32(i32, i32)
+
+; ---------------------------------------------------------------------------
+; Heap
+;
+; Each allocation is preceded by a 4-byte header holding its size, and the
+; next one starts at the following 4-byte boundary.
+
+define internal i32 @memory_end() #0 {
+  %pages = call i32 @llvm.wasm.memory.size.i32(i32 0)
+  %end = shl i32 %pages, 16
+  ret i32 %end
+}
+
+; region_end returns the end of the allocation of size bytes at data, aligned.
+define internal i32 @region_end(i32 %data, i32 %size) #0 {
+  %end = add i32 %data, %size
+  %up = add i32 %end, 3
+  %aligned = and i32 %up, -4
+  ret i32 %aligned
+}
+
+define void @reset_heap(i32 %start) #0 {
+  %up = add i32 %start, 3
+  %aligned = and i32 %up, -4
+  store i32 %aligned, i32* @heap_start
+  store i32 %aligned, i32* @next
+  %end = call i32 @memory_end()
+  store i32 %end, i32* @heap_end
+  ret void
+}
+
+define i32 @malloc(i32 %size) #0 {
+entry:
+  %next = load i32, i32* @next
+  %data = add i32 %next, 4
+  %overflow = icmp ugt i32 %size, 2147483647
+  br i1 %overflow, label %fail, label %fits
+
+fits:
+  %end = call i32 @region_end(i32 %data, i32 %size)
+  %heap_end = load i32, i32* @heap_end
+  %full = icmp ugt i32 %end, %heap_end
+  br i1 %full, label %grow, label %allocate
+
+grow:
+  %missing = sub i32 %end, %heap_end
+  %missing1 = sub i32 %missing, 1
+  %pages0 = lshr i32 %missing1, 16
+  %pages = add i32 %pages0, 1
+  %prev = call i32 @llvm.wasm.memory.grow.i32(i32 0, i32 %pages)
+  %failed = icmp eq i32 %prev, -1
+  br i1 %failed, label %fail, label %grown
+
+grown:
+  %new_end = call i32 @memory_end()
+  store i32 %new_end, i32* @heap_end
+  br label %allocate
+
+allocate:
+  %header = inttoptr i32 %next to i32*
+  store i32 %size, i32* %header
+  store i32 %end, i32* @next
+  ret i32 %data
+
+fail:
+  ret i32 0
+}
+
+define void @free(i32 %ptr) #0 {
+entry:
+  %null = icmp eq i32 %ptr, 0
+  br i1 %null, label %done, label %check
+
+check:
+  %region = sub i32 %ptr, 4
+  %header = inttoptr i32 %region to i32*
+  %size = load i32, i32* %header
+  %end = call i32 @region_end(i32 %ptr, i32 %size)
+  %next = load i32, i32* @next
+  %last = icmp eq i32 %end, %next
+  br i1 %last, label %reclaim, label %done
+
+reclaim:
+  store i32 %region, i32* @next
+  br label %done
+
+done:
+  ret void
+}
+
+define i32 @calloc(i32 %count, i32 %size) #0 {
+entry:
+  %product = call { i32, i1 } @llvm.umul.with.overflow.i32(i32 %count, i32 %size)
+  %total = extractvalue { i32, i1 } %product, 0
+  %overflow = extractvalue { i32, i1 } %product, 1
+  br i1 %overflow, label %fail, label %allocate
+
+allocate:
+  %ptr = call i32 @malloc(i32 %total)
+  %null = icmp eq i32 %ptr, 0
+  br i1 %null, label %fail, label %clear
+
+clear:
+  %cleared = call i32 @memset(i32 %ptr, i32 0, i32 %total)
+  ret i32 %ptr
+
+fail:
+  ret i32 0
+}
+
+declare { i32, i1 } @llvm.umul.with.overflow.i32(i32, i32)
+
+define i32 @realloc(i32 %ptr, i32 %size) #0 {
+entry:
+  %null = icmp eq i32 %ptr, 0
+  br i1 %null, label %fresh, label %existing
+
+fresh:
+  %fresh_ptr = call i32 @malloc(i32 %size)
+  ret i32 %fresh_ptr
+
+existing:
+  %region = sub i32 %ptr, 4
+  %header = inttoptr i32 %region to i32*
+  %old_size = load i32, i32* %header
+  %end = call i32 @region_end(i32 %ptr, i32 %old_size)
+  %next = load i32, i32* @next
+  %last = icmp eq i32 %end, %next
+  br i1 %last, label %in_place, label %move
+
+in_place:
+  ; the last allocation grows or shrinks where it is, keeping its data
+  store i32 %region, i32* @next
+  %same = call i32 @malloc(i32 %size)
+  %failed = icmp eq i32 %same, 0
+  br i1 %failed, label %restore, label %resized
+
+restore:
+  store i32 %end, i32* @next
+  ret i32 0
+
+resized:
+  ret i32 %same
+
+move:
+  %moved = call i32 @malloc(i32 %size)
+  %moved_null = icmp eq i32 %moved, 0
+  br i1 %moved_null, label %move_failed, label %copy
+
+move_failed:
+  ret i32 0
+
+copy:
+  %shrink = icmp ult i32 %size, %old_size
+  %n = select i1 %shrink, i32 %size, i32 %old_size
+  %copied = call i32 @memcpy(i32 %moved, i32 %ptr, i32 %n)
+  ret i32 %moved
+}
+
+; ---------------------------------------------------------------------------
+; Memory
+
+define i32 @memcpy(i32 %dst, i32 %src, i32 %n) #0 {
+entry:
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %body ]
+  %more = icmp ult i32 %i, %n
+  br i1 %more, label %body, label %done
+
+body:
+  %s = add i32 %src, %i
+  %sp = inttoptr i32 %s to i8*
+  %c = load i8, i8* %sp
+  %d = add i32 %dst, %i
+  %dp = inttoptr i32 %d to i8*
+  store i8 %c, i8* %dp
+  %i1 = add i32 %i, 1
+  br label %loop
+
+done:
+  ret i32 %dst
+}
+
+define i32 @memmove(i32 %dst, i32 %src, i32 %n) #0 {
+entry:
+  %forward = icmp ule i32 %dst, %src
+  br i1 %forward, label %copy_forward, label %backward
+
+copy_forward:
+  %r = call i32 @memcpy(i32 %dst, i32 %src, i32 %n)
+  ret i32 %dst
+
+backward:
+  %i = phi i32 [ %n, %entry ], [ %i1, %body ]
+  %more = icmp ugt i32 %i, 0
+  br i1 %more, label %body, label %done
+
+body:
+  %i1 = sub i32 %i, 1
+  %s = add i32 %src, %i1
+  %sp = inttoptr i32 %s to i8*
+  %c = load i8, i8* %sp
+  %d = add i32 %dst, %i1
+  %dp = inttoptr i32 %d to i8*
+  store i8 %c, i8* %dp
+  br label %backward
+
+done:
+  ret i32 %dst
+}
+
+define i32 @memset(i32 %dst, i32 %value, i32 %n) #0 {
+entry:
+  %c = trunc i32 %value to i8
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %body ]
+  %more = icmp ult i32 %i, %n
+  br i1 %more, label %body, label %done
+
+body:
+  %d = add i32 %dst, %i
+  %dp = inttoptr i32 %d to i8*
+  store i8 %c, i8* %dp
+  %i1 = add i32 %i, 1
+  br label %loop
+
+done:
+  ret i32 %dst
+}
+
+define i32 @memcmp(i32 %a, i32 %b, i32 %n) #0 {
+entry:
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %same ]
+  %more = icmp ult i32 %i, %n
+  br i1 %more, label %body, label %equal
+
+body:
+  %ai = add i32 %a, %i
+  %ap = inttoptr i32 %ai to i8*
+  %ac = load i8, i8* %ap
+  %bi = add i32 %b, %i
+  %bp = inttoptr i32 %bi to i8*
+  %bc = load i8, i8* %bp
+  %eq = icmp eq i8 %ac, %bc
+  %i1 = add i32 %i, 1
+  br i1 %eq, label %same, label %differ
+
+same:
+  br label %loop
+
+differ:
+  %ax = zext i8 %ac to i32
+  %bx = zext i8 %bc to i32
+  %diff = sub i32 %ax, %bx
+  ret i32 %diff
+
+equal:
+  ret i32 0
+}
+
+define i32 @memchr(i32 %s, i32 %value, i32 %n) #0 {
+entry:
+  %c = trunc i32 %value to i8
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %next ]
+  %more = icmp ult i32 %i, %n
+  br i1 %more, label %body, label %missing
+
+body:
+  %p = add i32 %s, %i
+  %pp = inttoptr i32 %p to i8*
+  %x = load i8, i8* %pp
+  %found = icmp eq i8 %x, %c
+  %i1 = add i32 %i, 1
+  br i1 %found, label %hit, label %next
+
+next:
+  br label %loop
+
+hit:
+  ret i32 %p
+
+missing:
+  ret i32 0
+}
+
+; ---------------------------------------------------------------------------
+; Strings
+
+define i32 @strlen(i32 %s) #0 {
+entry:
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %loop ]
+  %p = add i32 %s, %i
+  %pp = inttoptr i32 %p to i8*
+  %c = load i8, i8* %pp
+  %i1 = add i32 %i, 1
+  %end = icmp eq i8 %c, 0
+  br i1 %end, label %done, label %loop
+
+done:
+  ret i32 %i
+}
+
+define i32 @strcmp(i32 %a, i32 %b) #0 {
+  %r = call i32 @strncmp(i32 %a, i32 %b, i32 -1)
+  ret i32 %r
+}
+
+define i32 @strncmp(i32 %a, i32 %b, i32 %n) #0 {
+entry:
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %same ]
+  %more = icmp ult i32 %i, %n
+  br i1 %more, label %body, label %equal
+
+body:
+  %ai = add i32 %a, %i
+  %ap = inttoptr i32 %ai to i8*
+  %ac = load i8, i8* %ap
+  %bi = add i32 %b, %i
+  %bp = inttoptr i32 %bi to i8*
+  %bc = load i8, i8* %bp
+  %eq = icmp eq i8 %ac, %bc
+  %i1 = add i32 %i, 1
+  br i1 %eq, label %check_end, label %differ
+
+check_end:
+  %end = icmp eq i8 %ac, 0
+  br i1 %end, label %equal, label %same
+
+same:
+  br label %loop
+
+differ:
+  %ax = zext i8 %ac to i32
+  %bx = zext i8 %bc to i32
+  %diff = sub i32 %ax, %bx
+  ret i32 %diff
+
+equal:
+  ret i32 0
+}
+
+; strncpy copies at most n bytes of src and pads dst with zeros up to n bytes.
+define i32 @strncpy(i32 %dst, i32 %src, i32 %n) #0 {
+entry:
+  br label %copy
+
+copy:
+  %i = phi i32 [ 0, %entry ], [ %i1, %copy_body ]
+  %more = icmp ult i32 %i, %n
+  br i1 %more, label %copy_load, label %done
+
+copy_load:
+  %s = add i32 %src, %i
+  %sp = inttoptr i32 %s to i8*
+  %c = load i8, i8* %sp
+  %end = icmp eq i8 %c, 0
+  br i1 %end, label %pad, label %copy_body
+
+copy_body:
+  %d = add i32 %dst, %i
+  %dp = inttoptr i32 %d to i8*
+  store i8 %c, i8* %dp
+  %i1 = add i32 %i, 1
+  br label %copy
+
+pad:
+  %rest_at = add i32 %dst, %i
+  %rest = sub i32 %n, %i
+  %padded = call i32 @memset(i32 %rest_at, i32 0, i32 %rest)
+  br label %done
+
+done:
+  ret i32 %dst
+}
+
+; strncat appends at most n bytes of src to dst, followed by a terminating zero.
+define i32 @strncat(i32 %dst, i32 %src, i32 %n) #0 {
+entry:
+  %len = call i32 @strlen(i32 %dst)
+  %at = add i32 %dst, %len
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %body ]
+  %more = icmp ult i32 %i, %n
+  br i1 %more, label %load, label %done
+
+load:
+  %s = add i32 %src, %i
+  %sp = inttoptr i32 %s to i8*
+  %c = load i8, i8* %sp
+  %end = icmp eq i8 %c, 0
+  br i1 %end, label %done, label %body
+
+body:
+  %d = add i32 %at, %i
+  %dp = inttoptr i32 %d to i8*
+  store i8 %c, i8* %dp
+  %i1 = add i32 %i, 1
+  br label %loop
+
+done:
+  %last = phi i32 [ %i, %loop ], [ %i, %load ]
+  %z = add i32 %at, %last
+  %zp = inttoptr i32 %z to i8*
+  store i8 0, i8* %zp
+  ret i32 %dst
+}
+
+; ---------------------------------------------------------------------------
+; Wide characters
+
+; in_range reports whether first <= c <= last.
+define internal i1 @in_range(i32 %c, i32 %first, i32 %last) #0 {
+  %offset = sub i32 %c, %first
+  %width = sub i32 %last, %first
+  %in = icmp ule i32 %offset, %width
+  ret i1 %in
+}
+
+define i32 @iswdigit(i32 %c) #0 {
+  %in = call i1 @in_range(i32 %c, i32 48, i32 57)
+  %r = zext i1 %in to i32
+  ret i32 %r
+}
+
+define i32 @iswxdigit(i32 %c) #0 {
+  %digit = call i1 @in_range(i32 %c, i32 48, i32 57)
+  %lower = or i32 %c, 32
+  %letter = call i1 @in_range(i32 %lower, i32 97, i32 102)
+  %in = or i1 %digit, %letter
+  %r = zext i1 %in to i32
+  ret i32 %r
+}
+
+define i32 @iswblank(i32 %c) #0 {
+  %space = icmp eq i32 %c, 32
+  %tab = icmp eq i32 %c, 9
+  %in = or i1 %space, %tab
+  %r = zext i1 %in to i32
+  ret i32 %r
+}
+
+@spaces = internal constant [21 x i32] [
+  i32 32, i32 9, i32 10, i32 11, i32 12, i32 13, i32 133, i32 5760,
+  i32 8192, i32 8193, i32 8194, i32 8195, i32 8196, i32 8197, i32 8198,
+  i32 8200, i32 8201, i32 8202, i32 8232, i32 8233, i32 8287
+]
+
+define i32 @iswspace(i32 %c) #0 {
+entry:
+  %ideographic = icmp eq i32 %c, 12288
+  br i1 %ideographic, label %yes, label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %next ]
+  %more = icmp ult i32 %i, 21
+  br i1 %more, label %body, label %no
+
+body:
+  %p = getelementptr [21 x i32], [21 x i32]* @spaces, i32 0, i32 %i
+  %space = load i32, i32* %p
+  %match = icmp eq i32 %c, %space
+  %i1 = add i32 %i, 1
+  br i1 %match, label %yes, label %next
+
+next:
+  br label %loop
+
+yes:
+  ret i32 1
+
+no:
+  ret i32 0
+}
+
+; Ranges of code points of at least U+0100 that aren't letters, as first and last.
+@non_letters = internal constant [28 x i32] [
+  i32 768, i32 879,         ; combining diacritical marks
+  i32 8192, i32 11263,      ; punctuation, symbols, arrows, operators, shapes
+  i32 11776, i32 11903,     ; supplemental punctuation
+  i32 12288, i32 12292,     ; CJK punctuation
+  i32 12296, i32 12320,
+  i32 55296, i32 63743,     ; surrogates and private use
+  i32 65024, i32 65135,     ; variation selectors, vertical and small forms
+  i32 65280, i32 65312,     ; fullwidth punctuation
+  i32 65339, i32 65344,
+  i32 65371, i32 65381,
+  i32 65520, i32 65535,     ; specials
+  i32 126976, i32 129791,   ; emoji and other symbols
+  i32 917504, i32 1114111,  ; tags, variation selectors and private use
+  i32 1114112, i32 -1       ; not code points
+]
+
+define i32 @iswalpha(i32 %c) #0 {
+entry:
+  %lower = or i32 %c, 32
+  %ascii_letter = call i1 @in_range(i32 %lower, i32 97, i32 122)
+  br i1 %ascii_letter, label %yes, label %not_ascii_letter
+
+not_ascii_letter:
+  %ascii = icmp ult i32 %c, 256
+  br i1 %ascii, label %latin1, label %loop
+
+latin1:
+  %feminine = icmp eq i32 %c, 170
+  %micro = icmp eq i32 %c, 181
+  %masculine = icmp eq i32 %c, 186
+  %upper_block = icmp uge i32 %c, 192
+  %times = icmp eq i32 %c, 215
+  %divide = icmp eq i32 %c, 247
+  %sign = or i1 %times, %divide
+  %not_sign = xor i1 %sign, true
+  %block_letter = and i1 %upper_block, %not_sign
+  %a = or i1 %feminine, %micro
+  %b = or i1 %a, %masculine
+  %latin1_letter = or i1 %b, %block_letter
+  %r = zext i1 %latin1_letter to i32
+  ret i32 %r
+
+loop:
+  %i = phi i32 [ 0, %not_ascii_letter ], [ %i1, %next ]
+  %more = icmp ult i32 %i, 28
+  br i1 %more, label %body, label %yes
+
+body:
+  %fp = getelementptr [28 x i32], [28 x i32]* @non_letters, i32 0, i32 %i
+  %first = load i32, i32* %fp
+  %j = add i32 %i, 1
+  %lp = getelementptr [28 x i32], [28 x i32]* @non_letters, i32 0, i32 %j
+  %last = load i32, i32* %lp
+  %in = call i1 @in_range(i32 %c, i32 %first, i32 %last)
+  %i1 = add i32 %i, 2
+  br i1 %in, label %no, label %next
+
+next:
+  br label %loop
+
+yes:
+  ret i32 1
+
+no:
+  ret i32 0
+}
+
+define i32 @iswalnum(i32 %c) #0 {
+  %digit = call i32 @iswdigit(i32 %c)
+  %alpha = call i32 @iswalpha(i32 %c)
+  %r = or i32 %digit, %alpha
+  ret i32 %r
+}
+
+; Ranges of uppercase letters, as first, last, the offset to their lowercase letters,
+; and whether only every other code point, starting with first, is uppercase.
+@cases = internal constant [52 x i32] [
+  i32 65, i32 90, i32 32, i32 0,
+  i32 192, i32 214, i32 32, i32 0,
+  i32 216, i32 222, i32 32, i32 0,
+  i32 256, i32 302, i32 1, i32 1,
+  i32 306, i32 310, i32 1, i32 1,
+  i32 313, i32 327, i32 1, i32 1,
+  i32 330, i32 374, i32 1, i32 1,
+  i32 376, i32 376, i32 -121, i32 0,
+  i32 377, i32 381, i32 1, i32 1,
+  i32 913, i32 929, i32 32, i32 0,
+  i32 931, i32 939, i32 32, i32 0,
+  i32 1024, i32 1039, i32 80, i32 0,
+  i32 1040, i32 1071, i32 32, i32 0
+]
+
+; map_case maps c from the uppercase letters of @cases to the lowercase ones if direction
+; is 1, and back if it is -1.
+define internal i32 @map_case(i32 %c, i32 %direction) #0 {
+entry:
+  br label %loop
+
+loop:
+  %i = phi i32 [ 0, %entry ], [ %i1, %next ]
+  %more = icmp ult i32 %i, 52
+  br i1 %more, label %body, label %unmapped
+
+body:
+  %fp = getelementptr [52 x i32], [52 x i32]* @cases, i32 0, i32 %i
+  %first0 = load i32, i32* %fp
+  %i_last = add i32 %i, 1
+  %lp = getelementptr [52 x i32], [52 x i32]* @cases, i32 0, i32 %i_last
+  %last0 = load i32, i32* %lp
+  %i_offset = add i32 %i, 2
+  %op = getelementptr [52 x i32], [52 x i32]* @cases, i32 0, i32 %i_offset
+  %offset0 = load i32, i32* %op
+  %i_alternate = add i32 %i, 3
+  %ap = getelementptr [52 x i32], [52 x i32]* @cases, i32 0, i32 %i_alternate
+  %alternate = load i32, i32* %ap
+  %i1 = add i32 %i, 4
+  ; towupper looks c up among the lowercase letters
+  %back = icmp eq i32 %direction, -1
+  %shift = select i1 %back, i32 %offset0, i32 0
+  %first = add i32 %first0, %shift
+  %last = add i32 %last0, %shift
+  %offset = mul i32 %offset0, %direction
+  %in = call i1 @in_range(i32 %c, i32 %first, i32 %last)
+  br i1 %in, label %check_parity, label %next
+
+check_parity:
+  %distance = sub i32 %c, %first
+  %odd = and i32 %distance, %alternate
+  %skipped = icmp ne i32 %odd, 0
+  br i1 %skipped, label %unmapped, label %mapped
+
+mapped:
+  %r = add i32 %c, %offset
+  ret i32 %r
+
+next:
+  br label %loop
+
+unmapped:
+  ret i32 %c
+}
+
+define i32 @towlower(i32 %c) #0 {
+  %r = call i32 @map_case(i32 %c, i32 1)
+  ret i32 %r
+}
+
+define i32 @towupper(i32 %c) #0 {
+  %r = call i32 @map_case(i32 %c, i32 -1)
+  ret i32 %r
+}
+
+define i32 @iswupper(i32 %c) #0 {
+  %lower = call i32 @towlower(i32 %c)
+  %changed = icmp ne i32 %lower, %c
+  %r = zext i1 %changed to i32
+  ret i32 %r
+}
+
+define i32 @iswlower(i32 %c) #0 {
+  %upper = call i32 @towupper(i32 %c)
+  %changed = icmp ne i32 %upper, %c
+  %r = zext i1 %changed to i32
+  ret i32 %r
+}
+
+attributes #0 = { nounwind "no-builtins" }
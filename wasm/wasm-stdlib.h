@@ -0,0 +1,239 @@
+unsigned char STDLIB_WASM[] = {
+  0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x17, 0x04, 0x60,
+  0x01, 0x7f, 0x00, 0x60, 0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f,
+  0x01, 0x7f, 0x60, 0x03, 0x7f, 0x7f, 0x7f, 0x01, 0x7f, 0x02, 0x0f, 0x01,
+  0x03, 0x65, 0x6e, 0x76, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02,
+  0x00, 0x02, 0x03, 0x1b, 0x1a, 0x00, 0x01, 0x00, 0x02, 0x02, 0x03, 0x03,
+  0x03, 0x03, 0x03, 0x01, 0x02, 0x03, 0x03, 0x03, 0x01, 0x01, 0x01, 0x01,
+  0x01, 0x01, 0x02, 0x01, 0x01, 0x01, 0x01, 0x06, 0x08, 0x01, 0x7f, 0x01,
+  0x41, 0x80, 0x80, 0x04, 0x0b, 0x07, 0x90, 0x02, 0x1a, 0x0f, 0x5f, 0x5f,
+  0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65,
+  0x72, 0x03, 0x00, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x65,
+  0x61, 0x70, 0x00, 0x00, 0x06, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x00,
+  0x01, 0x04, 0x66, 0x72, 0x65, 0x65, 0x00, 0x02, 0x06, 0x63, 0x61, 0x6c,
+  0x6c, 0x6f, 0x63, 0x00, 0x03, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6c, 0x6f,
+  0x63, 0x00, 0x04, 0x06, 0x6d, 0x65, 0x6d, 0x63, 0x70, 0x79, 0x00, 0x05,
+  0x07, 0x6d, 0x65, 0x6d, 0x6d, 0x6f, 0x76, 0x65, 0x00, 0x06, 0x06, 0x6d,
+  0x65, 0x6d, 0x73, 0x65, 0x74, 0x00, 0x07, 0x06, 0x6d, 0x65, 0x6d, 0x63,
+  0x6d, 0x70, 0x00, 0x08, 0x06, 0x6d, 0x65, 0x6d, 0x63, 0x68, 0x72, 0x00,
+  0x09, 0x06, 0x73, 0x74, 0x72, 0x6c, 0x65, 0x6e, 0x00, 0x0a, 0x06, 0x73,
+  0x74, 0x72, 0x63, 0x6d, 0x70, 0x00, 0x0b, 0x07, 0x73, 0x74, 0x72, 0x6e,
+  0x63, 0x6d, 0x70, 0x00, 0x0c, 0x07, 0x73, 0x74, 0x72, 0x6e, 0x63, 0x70,
+  0x79, 0x00, 0x0d, 0x07, 0x73, 0x74, 0x72, 0x6e, 0x63, 0x61, 0x74, 0x00,
+  0x0e, 0x08, 0x69, 0x73, 0x77, 0x64, 0x69, 0x67, 0x69, 0x74, 0x00, 0x0f,
+  0x09, 0x69, 0x73, 0x77, 0x78, 0x64, 0x69, 0x67, 0x69, 0x74, 0x00, 0x10,
+  0x08, 0x69, 0x73, 0x77, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x00, 0x11, 0x08,
+  0x69, 0x73, 0x77, 0x73, 0x70, 0x61, 0x63, 0x65, 0x00, 0x12, 0x08, 0x69,
+  0x73, 0x77, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x00, 0x13, 0x08, 0x69, 0x73,
+  0x77, 0x61, 0x6c, 0x6e, 0x75, 0x6d, 0x00, 0x14, 0x08, 0x74, 0x6f, 0x77,
+  0x6c, 0x6f, 0x77, 0x65, 0x72, 0x00, 0x16, 0x08, 0x74, 0x6f, 0x77, 0x75,
+  0x70, 0x70, 0x65, 0x72, 0x00, 0x17, 0x08, 0x69, 0x73, 0x77, 0x75, 0x70,
+  0x70, 0x65, 0x72, 0x00, 0x18, 0x08, 0x69, 0x73, 0x77, 0x6c, 0x6f, 0x77,
+  0x65, 0x72, 0x00, 0x19, 0x0a, 0xec, 0x0d, 0x1a, 0x21, 0x00, 0x41, 0x00,
+  0x20, 0x00, 0x41, 0x03, 0x6a, 0x41, 0x7c, 0x71, 0x36, 0x02, 0xc4, 0x82,
+  0x84, 0x80, 0x00, 0x41, 0x00, 0x3f, 0x00, 0x41, 0x10, 0x74, 0x36, 0x02,
+  0xc0, 0x82, 0x84, 0x80, 0x00, 0x0b, 0x7d, 0x01, 0x03, 0x7f, 0x41, 0x00,
+  0x21, 0x01, 0x02, 0x40, 0x02, 0x40, 0x02, 0x40, 0x20, 0x00, 0x41, 0x00,
+  0x48, 0x0d, 0x00, 0x20, 0x00, 0x41, 0x00, 0x28, 0x02, 0xc4, 0x82, 0x84,
+  0x80, 0x00, 0x22, 0x01, 0x6a, 0x41, 0x07, 0x6a, 0x41, 0x7c, 0x71, 0x22,
+  0x02, 0x41, 0x00, 0x28, 0x02, 0xc0, 0x82, 0x84, 0x80, 0x00, 0x22, 0x03,
+  0x4d, 0x0d, 0x02, 0x20, 0x02, 0x20, 0x03, 0x41, 0x7f, 0x73, 0x6a, 0x41,
+  0x10, 0x76, 0x41, 0x01, 0x6a, 0x40, 0x00, 0x41, 0x7f, 0x47, 0x0d, 0x01,
+  0x41, 0x00, 0x21, 0x01, 0x0b, 0x20, 0x01, 0x0f, 0x0b, 0x41, 0x00, 0x3f,
+  0x00, 0x41, 0x10, 0x74, 0x36, 0x02, 0xc0, 0x82, 0x84, 0x80, 0x00, 0x0b,
+  0x20, 0x01, 0x20, 0x00, 0x36, 0x02, 0x00, 0x41, 0x00, 0x20, 0x02, 0x36,
+  0x02, 0xc4, 0x82, 0x84, 0x80, 0x00, 0x20, 0x01, 0x41, 0x04, 0x6a, 0x0b,
+  0x36, 0x01, 0x01, 0x7f, 0x02, 0x40, 0x20, 0x00, 0x45, 0x0d, 0x00, 0x20,
+  0x00, 0x20, 0x00, 0x41, 0x7c, 0x6a, 0x22, 0x01, 0x28, 0x02, 0x00, 0x6a,
+  0x41, 0x03, 0x6a, 0x41, 0x7c, 0x71, 0x41, 0x00, 0x28, 0x02, 0xc4, 0x82,
+  0x84, 0x80, 0x00, 0x47, 0x0d, 0x00, 0x41, 0x00, 0x20, 0x01, 0x36, 0x02,
+  0xc4, 0x82, 0x84, 0x80, 0x00, 0x0b, 0x0b, 0x58, 0x02, 0x01, 0x7f, 0x01,
+  0x7e, 0x41, 0x00, 0x21, 0x02, 0x02, 0x40, 0x20, 0x00, 0xad, 0x20, 0x01,
+  0xad, 0x7e, 0x22, 0x03, 0x42, 0x20, 0x88, 0xa7, 0x0d, 0x00, 0x20, 0x03,
+  0xa7, 0x22, 0x00, 0x10, 0x81, 0x80, 0x80, 0x80, 0x00, 0x22, 0x01, 0x45,
+  0x0d, 0x00, 0x41, 0x00, 0x21, 0x02, 0x03, 0x40, 0x02, 0x40, 0x20, 0x00,
+  0x20, 0x02, 0x47, 0x0d, 0x00, 0x20, 0x01, 0x21, 0x02, 0x0c, 0x02, 0x0b,
+  0x20, 0x01, 0x20, 0x02, 0x6a, 0x41, 0x00, 0x3a, 0x00, 0x00, 0x20, 0x02,
+  0x41, 0x01, 0x6a, 0x21, 0x02, 0x0c, 0x00, 0x0b, 0x0b, 0x20, 0x02, 0x0b,
+  0xb1, 0x01, 0x01, 0x04, 0x7f, 0x02, 0x40, 0x20, 0x00, 0x0d, 0x00, 0x20,
+  0x01, 0x10, 0x81, 0x80, 0x80, 0x80, 0x00, 0x0f, 0x0b, 0x41, 0x00, 0x21,
+  0x02, 0x02, 0x40, 0x02, 0x40, 0x20, 0x00, 0x20, 0x00, 0x41, 0x7c, 0x6a,
+  0x22, 0x03, 0x28, 0x02, 0x00, 0x22, 0x04, 0x6a, 0x41, 0x03, 0x6a, 0x41,
+  0x7c, 0x71, 0x22, 0x05, 0x41, 0x00, 0x28, 0x02, 0xc4, 0x82, 0x84, 0x80,
+  0x00, 0x47, 0x0d, 0x00, 0x41, 0x00, 0x20, 0x03, 0x36, 0x02, 0xc4, 0x82,
+  0x84, 0x80, 0x00, 0x20, 0x01, 0x10, 0x81, 0x80, 0x80, 0x80, 0x00, 0x22,
+  0x02, 0x0d, 0x01, 0x41, 0x00, 0x20, 0x05, 0x36, 0x02, 0xc4, 0x82, 0x84,
+  0x80, 0x00, 0x41, 0x00, 0x21, 0x02, 0x0c, 0x01, 0x0b, 0x20, 0x01, 0x10,
+  0x81, 0x80, 0x80, 0x80, 0x00, 0x22, 0x03, 0x45, 0x0d, 0x00, 0x20, 0x01,
+  0x20, 0x04, 0x20, 0x04, 0x20, 0x01, 0x4b, 0x1b, 0x21, 0x02, 0x41, 0x00,
+  0x21, 0x01, 0x03, 0x40, 0x02, 0x40, 0x20, 0x02, 0x20, 0x01, 0x47, 0x0d,
+  0x00, 0x20, 0x03, 0x0f, 0x0b, 0x20, 0x03, 0x20, 0x01, 0x6a, 0x20, 0x00,
+  0x20, 0x01, 0x6a, 0x2d, 0x00, 0x00, 0x3a, 0x00, 0x00, 0x20, 0x01, 0x41,
+  0x01, 0x6a, 0x21, 0x01, 0x0c, 0x00, 0x0b, 0x0b, 0x20, 0x02, 0x0b, 0x30,
+  0x01, 0x01, 0x7f, 0x41, 0x00, 0x21, 0x03, 0x02, 0x40, 0x03, 0x40, 0x20,
+  0x02, 0x20, 0x03, 0x46, 0x0d, 0x01, 0x20, 0x00, 0x20, 0x03, 0x6a, 0x20,
+  0x01, 0x20, 0x03, 0x6a, 0x2d, 0x00, 0x00, 0x3a, 0x00, 0x00, 0x20, 0x03,
+  0x41, 0x01, 0x6a, 0x21, 0x03, 0x0c, 0x00, 0x0b, 0x0b, 0x20, 0x00, 0x0b,
+  0x6f, 0x01, 0x01, 0x7f, 0x02, 0x40, 0x02, 0x40, 0x20, 0x00, 0x20, 0x01,
+  0x4b, 0x0d, 0x00, 0x20, 0x00, 0x21, 0x03, 0x03, 0x40, 0x20, 0x02, 0x45,
+  0x0d, 0x02, 0x20, 0x03, 0x20, 0x01, 0x2d, 0x00, 0x00, 0x3a, 0x00, 0x00,
+  0x20, 0x02, 0x41, 0x7f, 0x6a, 0x21, 0x02, 0x20, 0x01, 0x41, 0x01, 0x6a,
+  0x21, 0x01, 0x20, 0x03, 0x41, 0x01, 0x6a, 0x21, 0x03, 0x0c, 0x00, 0x0b,
+  0x0b, 0x20, 0x00, 0x41, 0x7f, 0x6a, 0x21, 0x03, 0x20, 0x01, 0x41, 0x7f,
+  0x6a, 0x21, 0x01, 0x03, 0x40, 0x20, 0x02, 0x45, 0x0d, 0x01, 0x20, 0x03,
+  0x20, 0x02, 0x6a, 0x20, 0x01, 0x20, 0x02, 0x6a, 0x2d, 0x00, 0x00, 0x3a,
+  0x00, 0x00, 0x20, 0x02, 0x41, 0x7f, 0x6a, 0x21, 0x02, 0x0c, 0x00, 0x0b,
+  0x0b, 0x20, 0x00, 0x0b, 0x2a, 0x01, 0x01, 0x7f, 0x41, 0x00, 0x21, 0x03,
+  0x02, 0x40, 0x03, 0x40, 0x20, 0x02, 0x20, 0x03, 0x46, 0x0d, 0x01, 0x20,
+  0x00, 0x20, 0x03, 0x6a, 0x20, 0x01, 0x3a, 0x00, 0x00, 0x20, 0x03, 0x41,
+  0x01, 0x6a, 0x21, 0x03, 0x0c, 0x00, 0x0b, 0x0b, 0x20, 0x00, 0x0b, 0x40,
+  0x01, 0x02, 0x7f, 0x03, 0x40, 0x02, 0x40, 0x20, 0x02, 0x0d, 0x00, 0x41,
+  0x00, 0x0f, 0x0b, 0x20, 0x02, 0x41, 0x7f, 0x6a, 0x21, 0x02, 0x20, 0x01,
+  0x2d, 0x00, 0x00, 0x21, 0x03, 0x20, 0x00, 0x2d, 0x00, 0x00, 0x21, 0x04,
+  0x20, 0x01, 0x41, 0x01, 0x6a, 0x21, 0x01, 0x20, 0x00, 0x41, 0x01, 0x6a,
+  0x21, 0x00, 0x20, 0x04, 0x20, 0x03, 0x46, 0x0d, 0x00, 0x0b, 0x20, 0x04,
+  0x20, 0x03, 0x6b, 0x0b, 0x3c, 0x01, 0x02, 0x7f, 0x20, 0x01, 0x41, 0xff,
+  0x01, 0x71, 0x21, 0x03, 0x03, 0x40, 0x02, 0x40, 0x20, 0x02, 0x0d, 0x00,
+  0x41, 0x00, 0x0f, 0x0b, 0x20, 0x02, 0x41, 0x7f, 0x6a, 0x21, 0x02, 0x20,
+  0x00, 0x2d, 0x00, 0x00, 0x21, 0x01, 0x20, 0x00, 0x41, 0x01, 0x6a, 0x22,
+  0x04, 0x21, 0x00, 0x20, 0x01, 0x20, 0x03, 0x47, 0x0d, 0x00, 0x0b, 0x20,
+  0x04, 0x41, 0x7f, 0x6a, 0x0b, 0x27, 0x01, 0x03, 0x7f, 0x41, 0x7f, 0x21,
+  0x01, 0x03, 0x40, 0x20, 0x00, 0x20, 0x01, 0x6a, 0x21, 0x02, 0x20, 0x01,
+  0x41, 0x01, 0x6a, 0x22, 0x03, 0x21, 0x01, 0x20, 0x02, 0x41, 0x01, 0x6a,
+  0x2d, 0x00, 0x00, 0x0d, 0x00, 0x0b, 0x20, 0x03, 0x0b, 0x0e, 0x00, 0x20,
+  0x00, 0x20, 0x01, 0x41, 0x7f, 0x10, 0x8c, 0x80, 0x80, 0x80, 0x00, 0x0b,
+  0x44, 0x01, 0x02, 0x7f, 0x02, 0x40, 0x02, 0x40, 0x03, 0x40, 0x20, 0x02,
+  0x45, 0x0d, 0x01, 0x20, 0x00, 0x2d, 0x00, 0x00, 0x22, 0x03, 0x20, 0x01,
+  0x2d, 0x00, 0x00, 0x22, 0x04, 0x47, 0x0d, 0x02, 0x20, 0x01, 0x41, 0x01,
+  0x6a, 0x21, 0x01, 0x20, 0x00, 0x41, 0x01, 0x6a, 0x21, 0x00, 0x20, 0x02,
+  0x41, 0x7f, 0x6a, 0x21, 0x02, 0x20, 0x03, 0x0d, 0x00, 0x0b, 0x0b, 0x41,
+  0x00, 0x0f, 0x0b, 0x20, 0x03, 0x20, 0x04, 0x6b, 0x0b, 0x64, 0x01, 0x03,
+  0x7f, 0x41, 0x00, 0x21, 0x03, 0x02, 0x40, 0x03, 0x40, 0x20, 0x02, 0x20,
+  0x03, 0x46, 0x0d, 0x01, 0x20, 0x00, 0x20, 0x03, 0x6a, 0x21, 0x04, 0x02,
+  0x40, 0x20, 0x01, 0x20, 0x03, 0x6a, 0x2d, 0x00, 0x00, 0x22, 0x05, 0x45,
+  0x0d, 0x00, 0x20, 0x04, 0x20, 0x05, 0x3a, 0x00, 0x00, 0x20, 0x03, 0x41,
+  0x01, 0x6a, 0x21, 0x03, 0x0c, 0x01, 0x0b, 0x0b, 0x20, 0x02, 0x20, 0x03,
+  0x6b, 0x21, 0x03, 0x03, 0x40, 0x20, 0x03, 0x45, 0x0d, 0x01, 0x20, 0x04,
+  0x41, 0x00, 0x3a, 0x00, 0x00, 0x20, 0x03, 0x41, 0x7f, 0x6a, 0x21, 0x03,
+  0x20, 0x04, 0x41, 0x01, 0x6a, 0x21, 0x04, 0x0c, 0x00, 0x0b, 0x0b, 0x20,
+  0x00, 0x0b, 0x5e, 0x01, 0x03, 0x7f, 0x20, 0x00, 0x41, 0x7f, 0x6a, 0x21,
+  0x03, 0x03, 0x40, 0x20, 0x03, 0x41, 0x01, 0x6a, 0x22, 0x03, 0x2d, 0x00,
+  0x00, 0x0d, 0x00, 0x0b, 0x41, 0x00, 0x21, 0x04, 0x03, 0x7f, 0x02, 0x40,
+  0x02, 0x40, 0x20, 0x02, 0x20, 0x04, 0x46, 0x0d, 0x00, 0x20, 0x01, 0x20,
+  0x04, 0x6a, 0x2d, 0x00, 0x00, 0x22, 0x05, 0x0d, 0x01, 0x20, 0x04, 0x21,
+  0x02, 0x0b, 0x20, 0x02, 0x20, 0x03, 0x6a, 0x41, 0x00, 0x3a, 0x00, 0x00,
+  0x20, 0x00, 0x0f, 0x0b, 0x20, 0x03, 0x20, 0x04, 0x6a, 0x20, 0x05, 0x3a,
+  0x00, 0x00, 0x20, 0x04, 0x41, 0x01, 0x6a, 0x21, 0x04, 0x0c, 0x00, 0x0b,
+  0x0b, 0x0a, 0x00, 0x20, 0x00, 0x41, 0x50, 0x6a, 0x41, 0x0a, 0x49, 0x0b,
+  0x17, 0x00, 0x20, 0x00, 0x41, 0x50, 0x6a, 0x41, 0x0a, 0x49, 0x20, 0x00,
+  0x41, 0x20, 0x72, 0x41, 0x9f, 0x7f, 0x6a, 0x41, 0x06, 0x49, 0x72, 0x0b,
+  0x0d, 0x00, 0x20, 0x00, 0x41, 0x20, 0x46, 0x20, 0x00, 0x41, 0x09, 0x46,
+  0x72, 0x0b, 0xc5, 0x01, 0x01, 0x02, 0x7f, 0x41, 0x01, 0x21, 0x01, 0x02,
+  0x40, 0x02, 0x40, 0x02, 0x40, 0x02, 0x40, 0x20, 0x00, 0x41, 0xff, 0x3f,
+  0x4a, 0x0d, 0x00, 0x20, 0x00, 0x41, 0x77, 0x6a, 0x22, 0x02, 0x41, 0x17,
+  0x4b, 0x0d, 0x01, 0x41, 0x01, 0x20, 0x02, 0x74, 0x41, 0x9f, 0x80, 0x80,
+  0x04, 0x71, 0x45, 0x0d, 0x01, 0x0c, 0x03, 0x0b, 0x02, 0x40, 0x20, 0x00,
+  0x41, 0x80, 0x40, 0x6a, 0x0e, 0x60, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+  0x03, 0x02, 0x03, 0x03, 0x03, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02,
+  0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02,
+  0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x03, 0x03,
+  0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02,
+  0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02,
+  0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02,
+  0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02,
+  0x02, 0x02, 0x02, 0x02, 0x02, 0x03, 0x00, 0x0b, 0x20, 0x00, 0x41, 0x80,
+  0xe0, 0x00, 0x46, 0x0d, 0x02, 0x0c, 0x01, 0x0b, 0x20, 0x00, 0x41, 0x85,
+  0x01, 0x46, 0x0d, 0x01, 0x20, 0x00, 0x41, 0x80, 0x2d, 0x46, 0x0d, 0x01,
+  0x0b, 0x41, 0x00, 0x21, 0x01, 0x0b, 0x20, 0x01, 0x0b, 0xad, 0x01, 0x01,
+  0x04, 0x7f, 0x02, 0x40, 0x20, 0x00, 0x41, 0x20, 0x72, 0x41, 0x9f, 0x7f,
+  0x6a, 0x41, 0x1a, 0x4f, 0x0d, 0x00, 0x41, 0x01, 0x0f, 0x0b, 0x02, 0x40,
+  0x20, 0x00, 0x41, 0x80, 0x02, 0x49, 0x0d, 0x00, 0x41, 0x80, 0x80, 0x84,
+  0x80, 0x00, 0x21, 0x01, 0x41, 0x7c, 0x21, 0x02, 0x03, 0x40, 0x02, 0x40,
+  0x20, 0x02, 0x41, 0x04, 0x6a, 0x22, 0x02, 0x41, 0x1b, 0x4d, 0x0d, 0x00,
+  0x41, 0x01, 0x0f, 0x0b, 0x02, 0x40, 0x20, 0x00, 0x20, 0x01, 0x28, 0x02,
+  0x00, 0x22, 0x03, 0x6b, 0x20, 0x01, 0x41, 0x04, 0x6a, 0x28, 0x02, 0x00,
+  0x20, 0x03, 0x6b, 0x4d, 0x0d, 0x00, 0x20, 0x01, 0x41, 0x08, 0x6a, 0x21,
+  0x03, 0x20, 0x01, 0x41, 0x0c, 0x6a, 0x21, 0x04, 0x20, 0x01, 0x41, 0x10,
+  0x6a, 0x21, 0x01, 0x20, 0x00, 0x20, 0x03, 0x28, 0x02, 0x00, 0x22, 0x03,
+  0x6b, 0x20, 0x04, 0x28, 0x02, 0x00, 0x20, 0x03, 0x6b, 0x4b, 0x0d, 0x01,
+  0x0b, 0x0b, 0x41, 0x00, 0x0f, 0x0b, 0x20, 0x00, 0x41, 0xb5, 0x01, 0x46,
+  0x20, 0x00, 0x41, 0x6f, 0x71, 0x41, 0xaa, 0x01, 0x46, 0x72, 0x20, 0x00,
+  0x41, 0xbf, 0x01, 0x4b, 0x20, 0x00, 0x41, 0x5f, 0x71, 0x41, 0xd7, 0x01,
+  0x47, 0x71, 0x72, 0x0b, 0x13, 0x00, 0x20, 0x00, 0x10, 0x93, 0x80, 0x80,
+  0x80, 0x00, 0x20, 0x00, 0x41, 0x50, 0x6a, 0x41, 0x0a, 0x49, 0x72, 0x0b,
+  0x87, 0x01, 0x01, 0x09, 0x7f, 0x41, 0xec, 0x80, 0x84, 0x80, 0x00, 0x21,
+  0x02, 0x41, 0x7c, 0x21, 0x03, 0x20, 0x01, 0x41, 0x7f, 0x46, 0x21, 0x04,
+  0x02, 0x40, 0x03, 0x40, 0x20, 0x03, 0x41, 0x04, 0x6a, 0x22, 0x03, 0x41,
+  0x33, 0x4b, 0x0d, 0x01, 0x20, 0x02, 0x41, 0x0c, 0x6a, 0x21, 0x05, 0x20,
+  0x02, 0x41, 0x04, 0x6a, 0x21, 0x06, 0x20, 0x02, 0x41, 0x08, 0x6a, 0x21,
+  0x07, 0x20, 0x02, 0x41, 0x10, 0x6a, 0x22, 0x08, 0x21, 0x02, 0x20, 0x00,
+  0x20, 0x05, 0x28, 0x02, 0x00, 0x22, 0x09, 0x41, 0x00, 0x20, 0x04, 0x1b,
+  0x22, 0x05, 0x20, 0x06, 0x28, 0x02, 0x00, 0x6a, 0x22, 0x06, 0x6b, 0x22,
+  0x0a, 0x20, 0x05, 0x20, 0x07, 0x28, 0x02, 0x00, 0x6a, 0x20, 0x06, 0x6b,
+  0x4b, 0x0d, 0x00, 0x0b, 0x41, 0x00, 0x20, 0x09, 0x20, 0x01, 0x6c, 0x20,
+  0x08, 0x28, 0x02, 0x00, 0x20, 0x0a, 0x71, 0x1b, 0x20, 0x00, 0x6a, 0x21,
+  0x00, 0x0b, 0x20, 0x00, 0x0b, 0x0c, 0x00, 0x20, 0x00, 0x41, 0x01, 0x10,
+  0x95, 0x80, 0x80, 0x80, 0x00, 0x0b, 0x0c, 0x00, 0x20, 0x00, 0x41, 0x7f,
+  0x10, 0x95, 0x80, 0x80, 0x80, 0x00, 0x0b, 0x0f, 0x00, 0x20, 0x00, 0x41,
+  0x01, 0x10, 0x95, 0x80, 0x80, 0x80, 0x00, 0x20, 0x00, 0x47, 0x0b, 0x0f,
+  0x00, 0x20, 0x00, 0x41, 0x7f, 0x10, 0x95, 0x80, 0x80, 0x80, 0x00, 0x20,
+  0x00, 0x47, 0x0b, 0x0b, 0xd8, 0x02, 0x02, 0x00, 0x41, 0x80, 0x80, 0x04,
+  0x0b, 0xc0, 0x02, 0x00, 0x03, 0x00, 0x00, 0x6f, 0x03, 0x00, 0x00, 0x00,
+  0x20, 0x00, 0x00, 0xff, 0x2b, 0x00, 0x00, 0x00, 0x2e, 0x00, 0x00, 0x7f,
+  0x2e, 0x00, 0x00, 0x00, 0x30, 0x00, 0x00, 0x04, 0x30, 0x00, 0x00, 0x08,
+  0x30, 0x00, 0x00, 0x20, 0x30, 0x00, 0x00, 0x00, 0xd8, 0x00, 0x00, 0xff,
+  0xf8, 0x00, 0x00, 0x00, 0xfe, 0x00, 0x00, 0x6f, 0xfe, 0x00, 0x00, 0x00,
+  0xff, 0x00, 0x00, 0x20, 0xff, 0x00, 0x00, 0x3b, 0xff, 0x00, 0x00, 0x40,
+  0xff, 0x00, 0x00, 0x5b, 0xff, 0x00, 0x00, 0x65, 0xff, 0x00, 0x00, 0xf0,
+  0xff, 0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x00, 0xf0, 0x01, 0x00, 0xff,
+  0xfa, 0x01, 0x00, 0x00, 0x00, 0x0e, 0x00, 0xff, 0xff, 0x10, 0x00, 0x00,
+  0x00, 0x11, 0x00, 0xff, 0xff, 0xff, 0xff, 0x41, 0x00, 0x00, 0x00, 0x5a,
+  0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0,
+  0x00, 0x00, 0x00, 0xd6, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00,
+  0x00, 0x00, 0x00, 0xd8, 0x00, 0x00, 0x00, 0xde, 0x00, 0x00, 0x00, 0x20,
+  0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x2e,
+  0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x32,
+  0x01, 0x00, 0x00, 0x36, 0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01,
+  0x00, 0x00, 0x00, 0x39, 0x01, 0x00, 0x00, 0x47, 0x01, 0x00, 0x00, 0x01,
+  0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x4a, 0x01, 0x00, 0x00, 0x76,
+  0x01, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x78,
+  0x01, 0x00, 0x00, 0x78, 0x01, 0x00, 0x00, 0x87, 0xff, 0xff, 0xff, 0x00,
+  0x00, 0x00, 0x00, 0x79, 0x01, 0x00, 0x00, 0x7d, 0x01, 0x00, 0x00, 0x01,
+  0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x91, 0x03, 0x00, 0x00, 0xa1,
+  0x03, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa3,
+  0x03, 0x00, 0x00, 0xab, 0x03, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00,
+  0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x0f, 0x04, 0x00, 0x00, 0x50,
+  0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x04, 0x00, 0x00, 0x2f,
+  0x04, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+  0x41, 0xc0, 0x82, 0x04, 0x0b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+  0x00, 0x00, 0x00, 0xab, 0x02, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x00, 0x0c,
+  0x0b, 0x73, 0x74, 0x64, 0x6c, 0x69, 0x62, 0x2e, 0x77, 0x61, 0x73, 0x6d,
+  0x01, 0xef, 0x01, 0x1a, 0x00, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f,
+  0x68, 0x65, 0x61, 0x70, 0x01, 0x06, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+  0x02, 0x04, 0x66, 0x72, 0x65, 0x65, 0x03, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+  0x6f, 0x63, 0x04, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x05,
+  0x06, 0x6d, 0x65, 0x6d, 0x63, 0x70, 0x79, 0x06, 0x07, 0x6d, 0x65, 0x6d,
+  0x6d, 0x6f, 0x76, 0x65, 0x07, 0x06, 0x6d, 0x65, 0x6d, 0x73, 0x65, 0x74,
+  0x08, 0x06, 0x6d, 0x65, 0x6d, 0x63, 0x6d, 0x70, 0x09, 0x06, 0x6d, 0x65,
+  0x6d, 0x63, 0x68, 0x72, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x6c, 0x65, 0x6e,
+  0x0b, 0x06, 0x73, 0x74, 0x72, 0x63, 0x6d, 0x70, 0x0c, 0x07, 0x73, 0x74,
+  0x72, 0x6e, 0x63, 0x6d, 0x70, 0x0d, 0x07, 0x73, 0x74, 0x72, 0x6e, 0x63,
+  0x70, 0x79, 0x0e, 0x07, 0x73, 0x74, 0x72, 0x6e, 0x63, 0x61, 0x74, 0x0f,
+  0x08, 0x69, 0x73, 0x77, 0x64, 0x69, 0x67, 0x69, 0x74, 0x10, 0x09, 0x69,
+  0x73, 0x77, 0x78, 0x64, 0x69, 0x67, 0x69, 0x74, 0x11, 0x08, 0x69, 0x73,
+  0x77, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x12, 0x08, 0x69, 0x73, 0x77, 0x73,
+  0x70, 0x61, 0x63, 0x65, 0x13, 0x08, 0x69, 0x73, 0x77, 0x61, 0x6c, 0x70,
+  0x68, 0x61, 0x14, 0x08, 0x69, 0x73, 0x77, 0x61, 0x6c, 0x6e, 0x75, 0x6d,
+  0x15, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x16, 0x08,
+  0x74, 0x6f, 0x77, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x17, 0x08, 0x74, 0x6f,
+  0x77, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x08, 0x69, 0x73, 0x77, 0x75,
+  0x70, 0x70, 0x65, 0x72, 0x19, 0x08, 0x69, 0x73, 0x77, 0x6c, 0x6f, 0x77,
+  0x65, 0x72, 0x07, 0x12, 0x01, 0x00, 0x0f, 0x5f, 0x5f, 0x73, 0x74, 0x61,
+  0x63, 0x6b, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x09, 0x10,
+  0x02, 0x00, 0x07, 0x2e, 0x72, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x01, 0x04,
+  0x2e, 0x62, 0x73, 0x73, 0x00, 0x22, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
+  0x74, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x01, 0x2b,
+  0x0f, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2d, 0x67, 0x6c, 0x6f,
+  0x62, 0x61, 0x6c, 0x73
+};
+unsigned int STDLIB_WASM_LEN = 2824;
@@ -0,0 +1,54 @@
+package treesitter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+// wasmLanguageName returns the name of the grammar compiled to the WebAssembly module wasm,
+// from the tree_sitter_<name> function it exports.
+func wasmLanguageName(wasm []byte) (string, error) {
+	if !bytes.HasPrefix(wasm, []byte("\x00asm")) || len(wasm) < 8 {
+		return "", errors.New("not a WebAssembly module")
+	}
+	r := bytes.NewReader(wasm[8:])
+	for r.Len() > 0 {
+		id, _ := r.ReadByte()
+		size, err := binary.ReadUvarint(r)
+		if err != nil || size > uint64(r.Len()) {
+			return "", errors.New("truncated WebAssembly module")
+		}
+		section := make([]byte, size)
+		r.Read(section)
+		const exportSection = 7
+		if id != exportSection {
+			continue
+		}
+
+		s := bytes.NewReader(section)
+		count, err := binary.ReadUvarint(s)
+		if err != nil {
+			return "", errors.New("invalid WebAssembly export section")
+		}
+		for range count {
+			n, err := binary.ReadUvarint(s)
+			if err != nil || n > uint64(s.Len()) {
+				return "", errors.New("invalid WebAssembly export section")
+			}
+			name := make([]byte, n)
+			s.Read(name)
+			kind, _ := s.ReadByte()
+			if _, err := binary.ReadUvarint(s); err != nil {
+				return "", errors.New("invalid WebAssembly export section")
+			}
+			const functionExport = 0
+			if lang, ok := strings.CutPrefix(string(name), "tree_sitter_"); ok && kind == functionExport &&
+				!strings.Contains(lang, "_external_scanner_") {
+				return lang, nil
+			}
+		}
+	}
+	return "", errors.New("WebAssembly module exports no tree-sitter language")
+}
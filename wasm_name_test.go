@@ -0,0 +1,42 @@
+package treesitter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWasmLanguageName(t *testing.T) {
+	assert := assert.New(t)
+
+	header := []byte("\x00asm\x01\x00\x00\x00")
+	export := func(name string, kind byte) []byte {
+		return append(append([]byte{byte(len(name))}, name...), kind, 0)
+	}
+	module := func(exports ...[]byte) []byte {
+		section := []byte{byte(len(exports))}
+		for _, e := range exports {
+			section = append(section, e...)
+		}
+		// a custom section, skipped, then the export section
+		m := append(append([]byte{}, header...), 0, 3, 1, 'x', 0)
+		return append(append(m, 7, byte(len(section))), section...)
+	}
+
+	name, err := wasmLanguageName(module(
+		export("memory", 2),
+		export("tree_sitter_json_external_scanner_create", 0),
+		export("tree_sitter_json", 0),
+	))
+	assert.NoError(err)
+	assert.Equal("json", name)
+
+	_, err = wasmLanguageName(module(export("main", 0)))
+	assert.Error(err)
+	_, err = wasmLanguageName(header)
+	assert.Error(err)
+	_, err = wasmLanguageName([]byte("not wasm"))
+	assert.Error(err)
+	_, err = wasmLanguageName(append(append([]byte{}, header...), 7, 100))
+	assert.Error(err)
+}
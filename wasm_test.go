@@ -0,0 +1,31 @@
+//go:build treesitter_wasm
+
+package treesitter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadLanguageWASM(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := LoadLanguageWASM([]byte("not wasm"))
+	assert.Error(err)
+
+	// a module exporting tree_sitter_test, but which isn't a grammar: the store with
+	// the vendored standard library is created, and loading the module fails
+	name := "tree_sitter_test"
+	module := []byte("\x00asm\x01\x00\x00\x00")
+	module = append(module, 1, 5, 1, 0x60, 0, 1, 0x7f)
+	module = append(module, 3, 2, 1, 0)
+	module = append(module, 7, byte(len(name)+4), 1, byte(len(name)))
+	module = append(append(module, name...), 0, 0)
+	module = append(module, 10, 6, 1, 4, 0, 0x41, 0, 0x0b)
+	_, err = LoadLanguageWASM(module)
+	if assert.Error(err) {
+		assert.Contains(err.Error(), "loading test")
+		assert.NotContains(err.Error(), "creating WebAssembly store")
+	}
+}